@@ -0,0 +1,68 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+// chainedAssumeRoleCredentialsProvider assumes each role in turn, starting from the credentials in cfg,
+// and returns a provider for the credentials of the last role.
+func chainedAssumeRoleCredentialsProvider(ctx context.Context, cfg aws.Config, stsEndpoint string, roles []*awsbase.AssumeRole) (aws.CredentialsProvider, error) {
+	for _, ar := range roles {
+		if ar == nil || ar.RoleARN == "" {
+			return nil, errors.New("role_arn is required for each chained assume_role")
+		}
+
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", ar.RoleARN, ar.SessionName, ar.ExternalID)
+
+		client := sts.NewFromConfig(cfg, func(opts *sts.Options) {
+			if stsEndpoint != "" {
+				opts.EndpointResolver = sts.EndpointResolverFromURL(stsEndpoint)
+			}
+		})
+
+		provider := stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(opts *stscreds.AssumeRoleOptions) {
+			opts.RoleSessionName = ar.SessionName
+			opts.Duration = ar.Duration
+
+			if ar.ExternalID != "" {
+				opts.ExternalID = aws.String(ar.ExternalID)
+			}
+
+			if ar.Policy != "" {
+				opts.Policy = aws.String(ar.Policy)
+			}
+
+			for _, policyARN := range ar.PolicyARNs {
+				opts.PolicyARNs = append(opts.PolicyARNs, types.PolicyDescriptorType{
+					Arn: aws.String(policyARN),
+				})
+			}
+
+			for k, v := range ar.Tags {
+				opts.Tags = append(opts.Tags, types.Tag{
+					Key:   aws.String(k),
+					Value: aws.String(v),
+				})
+			}
+
+			opts.TransitiveTagKeys = ar.TransitiveTagKeys
+		})
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return nil, fmt.Errorf("error assuming IAM Role (%s): %w", ar.RoleARN, err)
+		}
+
+		cfg.Credentials = aws.NewCredentialsCache(provider)
+	}
+
+	return cfg.Credentials, nil
+}
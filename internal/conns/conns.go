@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -71,6 +72,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/aws/aws-sdk-go/service/connectcontactlens"
 	"github.com/aws/aws-sdk-go/service/connectparticipant"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
 	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
@@ -280,6 +282,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/workmail"
 	"github.com/aws/aws-sdk-go/service/workmailmessageflow"
 	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
@@ -557,11 +560,13 @@ const (
 	WAFRegional                   = "wafregional"
 	WAFV2                         = "wafv2"
 	WellArchitected               = "wellarchitected"
+	Wisdom                        = "wisdom"
 	WorkDocs                      = "workdocs"
 	WorkLink                      = "worklink"
 	WorkMail                      = "workmail"
 	WorkMailMessageFlow           = "workmailmessageflow"
 	WorkSpaces                    = "workspaces"
+	WorkSpacesWeb                 = "workspacesweb"
 	XRay                          = "xray"
 )
 
@@ -846,18 +851,23 @@ func init() {
 	serviceData[WAFRegional] = &ServiceDatum{AWSClientName: "WAFRegional", AWSServiceName: wafregional.ServiceName, AWSEndpointsID: wafregional.EndpointsID, AWSServiceID: wafregional.ServiceID, ProviderNameUpper: "WAFRegional", HCLKeys: []string{"wafregional"}}
 	serviceData[WAFV2] = &ServiceDatum{AWSClientName: "WAFV2", AWSServiceName: wafv2.ServiceName, AWSEndpointsID: wafv2.EndpointsID, AWSServiceID: wafv2.ServiceID, ProviderNameUpper: "WAFV2", HCLKeys: []string{"wafv2"}}
 	serviceData[WellArchitected] = &ServiceDatum{AWSClientName: "WellArchitected", AWSServiceName: wellarchitected.ServiceName, AWSEndpointsID: wellarchitected.EndpointsID, AWSServiceID: wellarchitected.ServiceID, ProviderNameUpper: "WellArchitected", HCLKeys: []string{"wellarchitected"}}
+	serviceData[Wisdom] = &ServiceDatum{AWSClientName: "ConnectWisdomService", AWSServiceName: connectwisdomservice.ServiceName, AWSEndpointsID: connectwisdomservice.EndpointsID, AWSServiceID: connectwisdomservice.ServiceID, ProviderNameUpper: "Wisdom", HCLKeys: []string{"wisdom", "connectwisdomservice"}}
 	serviceData[WorkDocs] = &ServiceDatum{AWSClientName: "WorkDocs", AWSServiceName: workdocs.ServiceName, AWSEndpointsID: workdocs.EndpointsID, AWSServiceID: workdocs.ServiceID, ProviderNameUpper: "WorkDocs", HCLKeys: []string{"workdocs"}}
 	serviceData[WorkLink] = &ServiceDatum{AWSClientName: "WorkLink", AWSServiceName: worklink.ServiceName, AWSEndpointsID: worklink.EndpointsID, AWSServiceID: worklink.ServiceID, ProviderNameUpper: "WorkLink", HCLKeys: []string{"worklink"}}
 	serviceData[WorkMail] = &ServiceDatum{AWSClientName: "WorkMail", AWSServiceName: workmail.ServiceName, AWSEndpointsID: workmail.EndpointsID, AWSServiceID: workmail.ServiceID, ProviderNameUpper: "WorkMail", HCLKeys: []string{"workmail"}}
 	serviceData[WorkMailMessageFlow] = &ServiceDatum{AWSClientName: "WorkMailMessageFlow", AWSServiceName: workmailmessageflow.ServiceName, AWSEndpointsID: workmailmessageflow.EndpointsID, AWSServiceID: workmailmessageflow.ServiceID, ProviderNameUpper: "WorkMailMessageFlow", HCLKeys: []string{"workmailmessageflow"}}
 	serviceData[WorkSpaces] = &ServiceDatum{AWSClientName: "WorkSpaces", AWSServiceName: workspaces.ServiceName, AWSEndpointsID: workspaces.EndpointsID, AWSServiceID: workspaces.ServiceID, ProviderNameUpper: "WorkSpaces", HCLKeys: []string{"workspaces"}}
+	serviceData[WorkSpacesWeb] = &ServiceDatum{AWSClientName: "WorkSpacesWeb", AWSServiceName: workspacesweb.ServiceName, AWSEndpointsID: workspacesweb.EndpointsID, AWSServiceID: workspacesweb.ServiceID, ProviderNameUpper: "WorkSpacesWeb", HCLKeys: []string{"workspacesweb"}}
 	serviceData[XRay] = &ServiceDatum{AWSClientName: "XRay", AWSServiceName: xray.ServiceName, AWSEndpointsID: xray.EndpointsID, AWSServiceID: xray.ServiceID, ProviderNameUpper: "XRay", HCLKeys: []string{"xray"}}
 }
 
 type Config struct {
+	AbandonDeleteAfterAttempts     int
 	AccessKey                      string
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
+	AssumeRoleChain                []*awsbase.AssumeRole
+	DebugLoggingConfig             *DebugLoggingConfig
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -868,6 +878,7 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	ReadOnly                       bool
 	Region                         string
 	S3UsePathStyle                 bool
 	SecretKey                      string
@@ -882,10 +893,14 @@ type Config struct {
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
+	UseDualStackEndpointOverrides  map[string]bool
 	UseFIPSEndpoint                bool
+	UseFIPSEndpointOverrides       map[string]bool
+	UserAgent                      awsbase.UserAgentProducts
 }
 
 type AWSClient struct {
+	AbandonDeleteAfterAttempts        int
 	AccessAnalyzerConn                *accessanalyzer.AccessAnalyzer
 	AccountConn                       *account.Account
 	AccountID                         string
@@ -1099,6 +1114,7 @@ type AWSClient struct {
 	RAMConn                           *ram.RAM
 	RDSConn                           *rds.RDS
 	RDSDataConn                       *rdsdataservice.RDSDataService
+	ReadOnly                          bool
 	RedshiftConn                      *redshift.Redshift
 	RedshiftDataConn                  *redshiftdataapiservice.RedshiftDataAPIService
 	Region                            string
@@ -1158,15 +1174,18 @@ type AWSClient struct {
 	TranscribeStreamingConn           *transcribestreamingservice.TranscribeStreamingService
 	TransferConn                      *transfer.Transfer
 	TranslateConn                     *translate.Translate
+	UserAgent                         awsbase.UserAgentProducts
 	WAFConn                           *waf.WAF
 	WAFRegionalConn                   *wafregional.WAFRegional
 	WAFV2Conn                         *wafv2.WAFV2
 	WellArchitectedConn               *wellarchitected.WellArchitected
+	WisdomConn                        *connectwisdomservice.ConnectWisdomService
 	WorkDocsConn                      *workdocs.WorkDocs
 	WorkLinkConn                      *worklink.WorkLink
 	WorkMailConn                      *workmail.WorkMail
 	WorkMailMessageFlowConn           *workmailmessageflow.WorkMailMessageFlow
 	WorkSpacesConn                    *workspaces.WorkSpaces
+	WorkSpacesWebConn                 *workspacesweb.WorkSpacesWeb
 	XRayConn                          *xray.XRay
 }
 
@@ -1184,6 +1203,32 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
 
+// serviceConfig returns the AWS SDK for Go v1 configuration for the specified service's client.
+// Any per-service FIPS or DualStack endpoint override takes precedence over the provider-level setting.
+func (c *Config) serviceConfig(service string) *aws.Config {
+	config := &aws.Config{
+		Endpoint: aws.String(c.Endpoints[service]),
+	}
+
+	if v, ok := c.UseDualStackEndpointOverrides[service]; ok {
+		if v {
+			config.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
+		} else {
+			config.UseDualStackEndpoint = endpoints.DualStackEndpointStateDisabled
+		}
+	}
+
+	if v, ok := c.UseFIPSEndpointOverrides[service]; ok {
+		if v {
+			config.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+		} else {
+			config.UseFIPSEndpoint = endpoints.FIPSEndpointStateDisabled
+		}
+	}
+
+	return config
+}
+
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client() (interface{}, error) {
 	// Get the auth and region. This can fail if keys/regions were not
@@ -1199,7 +1244,7 @@ func (c *Config) Client() (interface{}, error) {
 		APNInfo:                 StdUserAgentProducts(c.TerraformVersion),
 		CallerDocumentationURL:  "https://registry.terraform.io/providers/hashicorp/aws",
 		CallerName:              "Terraform AWS Provider",
-		DebugLogging:            true, // Until https://github.com/hashicorp/aws-sdk-go-base/issues/96 is implemented
+		DebugLogging:            c.DebugLoggingConfig == nil, // Until https://github.com/hashicorp/aws-sdk-go-base/issues/96 is implemented
 		IamEndpoint:             c.Endpoints[IAM],
 		Insecure:                c.Insecure,
 		HTTPProxy:               c.HTTPProxy,
@@ -1214,6 +1259,7 @@ func (c *Config) Client() (interface{}, error) {
 		Token:                   c.Token,
 		UseDualStackEndpoint:    c.UseDualStackEndpoint,
 		UseFIPSEndpoint:         c.UseFIPSEndpoint,
+		UserAgent:               c.UserAgent,
 	}
 
 	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
@@ -1239,11 +1285,31 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	if len(c.AssumeRoleChain) > 0 {
+		cfg.Credentials, err = chainedAssumeRoleCredentialsProvider(ctx, cfg, c.Endpoints[STS], c.AssumeRoleChain)
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
+	// The AWS SDK for Go v1 session shares the AWS SDK for Go v2 HTTP client,
+	// so installing the logging transport here covers all service clients.
+	if c.DebugLoggingConfig != nil {
+		if httpClient, ok := cfg.HTTPClient.(*http.Client); ok {
+			httpClient.Transport = NewLoggingTransport(c.DebugLoggingConfig, httpClient.Transport)
+		}
+	}
+
 	sess, err := awsbasev1.GetSession(&cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error creating AWS SDK v1 session: %w", err)
 	}
 
+	// Service clients copy the session's handlers, so this covers all of them.
+	if c.ReadOnly {
+		sess.Handlers.Validate.PushFrontNamed(ReadOnlyHandler)
+	}
+
 	accountID, Partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving account details: %w", err)
@@ -1279,305 +1345,298 @@ func (c *Config) Client() (interface{}, error) {
 	}
 
 	client := &AWSClient{
-		AccessAnalyzerConn:                accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AccessAnalyzer])})),
-		AccountConn:                       account.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Account])})),
+		AbandonDeleteAfterAttempts:        c.AbandonDeleteAfterAttempts,
+		AccessAnalyzerConn:                accessanalyzer.New(sess.Copy(c.serviceConfig(AccessAnalyzer))),
+		AccountConn:                       account.New(sess.Copy(c.serviceConfig(Account))),
 		AccountID:                         accountID,
-		ACMConn:                           acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ACM])})),
-		ACMPCAConn:                        acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ACMPCA])})),
-		AlexaForBusinessConn:              alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AlexaForBusiness])})),
-		AMPConn:                           prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AMP])})),
-		AmplifyBackendConn:                amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AmplifyBackend])})),
-		AmplifyConn:                       amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Amplify])})),
-		APIGatewayConn:                    apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[APIGateway])})),
-		APIGatewayV2Conn:                  apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[APIGatewayV2])})),
-		AppAutoScalingConn:                applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppAutoScaling])})),
-		AppConfigConn:                     appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppConfig])})),
-		AppFlowConn:                       appflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppFlow])})),
-		AppIntegrationsConn:               appintegrationsservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppIntegrations])})),
-		ApplicationCostProfilerConn:       applicationcostprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ApplicationCostProfiler])})),
-		ApplicationDiscoveryConn:          applicationdiscoveryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ApplicationDiscovery])})),
-		ApplicationInsightsConn:           applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ApplicationInsights])})),
-		AppMeshConn:                       appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppMesh])})),
-		AppRegistryConn:                   appregistry.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppRegistry])})),
-		AppRunnerConn:                     apprunner.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppRunner])})),
-		AppStreamConn:                     appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppStream])})),
-		AppSyncConn:                       appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppSync])})),
-		AthenaConn:                        athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Athena])})),
-		AuditManagerConn:                  auditmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AuditManager])})),
-		AugmentedAIRuntimeConn:            augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AugmentedAIRuntime])})),
-		AutoScalingConn:                   autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AutoScaling])})),
-		AutoScalingPlansConn:              autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AutoScalingPlans])})),
-		BackupConn:                        backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Backup])})),
-		BatchConn:                         batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Batch])})),
-		BraketConn:                        braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Braket])})),
-		BudgetsConn:                       budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Budgets])})),
-		ChimeConn:                         chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Chime])})),
-		Cloud9Conn:                        cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Cloud9])})),
-		CloudControlConn:                  cloudcontrolapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudControl])})),
-		CloudDirectoryConn:                clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudDirectory])})),
-		CloudFormationConn:                cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudFormation])})),
-		CloudFrontConn:                    cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudFront])})),
-		CloudHSMV2Conn:                    cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudHSMV2])})),
-		CloudSearchConn:                   cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudSearch])})),
-		CloudSearchDomainConn:             cloudsearchdomain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudSearchDomain])})),
-		CloudTrailConn:                    cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudTrail])})),
-		CloudWatchConn:                    cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudWatch])})),
-		CloudWatchLogsConn:                cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudWatchLogs])})),
-		CodeArtifactConn:                  codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeArtifact])})),
-		CodeBuildConn:                     codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeBuild])})),
-		CodeCommitConn:                    codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeCommit])})),
-		CodeDeployConn:                    codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeDeploy])})),
-		CodeGuruProfilerConn:              codeguruprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeGuruProfiler])})),
-		CodeGuruReviewerConn:              codegurureviewer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeGuruReviewer])})),
-		CodePipelineConn:                  codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodePipeline])})),
-		CodeStarConn:                      codestar.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeStar])})),
-		CodeStarConnectionsConn:           codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeStarConnections])})),
-		CodeStarNotificationsConn:         codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeStarNotifications])})),
-		CognitoIdentityConn:               cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CognitoIdentity])})),
-		CognitoIDPConn:                    cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CognitoIDP])})),
-		CognitoSyncConn:                   cognitosync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CognitoSync])})),
-		ComprehendConn:                    comprehend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Comprehend])})),
-		ComprehendMedicalConn:             comprehendmedical.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ComprehendMedical])})),
-		ConfigServiceConn:                 configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ConfigService])})),
-		ConnectConn:                       connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Connect])})),
-		ConnectContactLensConn:            connectcontactlens.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ConnectContactLens])})),
-		ConnectParticipantConn:            connectparticipant.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ConnectParticipant])})),
-		CostExplorerConn:                  costexplorer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CostExplorer])})),
-		CURConn:                           costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CUR])})),
-		DataExchangeConn:                  dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataExchange])})),
-		DataPipelineConn:                  datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataPipeline])})),
-		DataSyncConn:                      datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataSync])})),
-		DAXConn:                           dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DAX])})),
+		ACMConn:                           acm.New(sess.Copy(c.serviceConfig(ACM))),
+		ACMPCAConn:                        acmpca.New(sess.Copy(c.serviceConfig(ACMPCA))),
+		AlexaForBusinessConn:              alexaforbusiness.New(sess.Copy(c.serviceConfig(AlexaForBusiness))),
+		AMPConn:                           prometheusservice.New(sess.Copy(c.serviceConfig(AMP))),
+		AmplifyBackendConn:                amplifybackend.New(sess.Copy(c.serviceConfig(AmplifyBackend))),
+		AmplifyConn:                       amplify.New(sess.Copy(c.serviceConfig(Amplify))),
+		APIGatewayConn:                    apigateway.New(sess.Copy(c.serviceConfig(APIGateway))),
+		APIGatewayV2Conn:                  apigatewayv2.New(sess.Copy(c.serviceConfig(APIGatewayV2))),
+		AppAutoScalingConn:                applicationautoscaling.New(sess.Copy(c.serviceConfig(AppAutoScaling))),
+		AppConfigConn:                     appconfig.New(sess.Copy(c.serviceConfig(AppConfig))),
+		AppFlowConn:                       appflow.New(sess.Copy(c.serviceConfig(AppFlow))),
+		AppIntegrationsConn:               appintegrationsservice.New(sess.Copy(c.serviceConfig(AppIntegrations))),
+		ApplicationCostProfilerConn:       applicationcostprofiler.New(sess.Copy(c.serviceConfig(ApplicationCostProfiler))),
+		ApplicationDiscoveryConn:          applicationdiscoveryservice.New(sess.Copy(c.serviceConfig(ApplicationDiscovery))),
+		ApplicationInsightsConn:           applicationinsights.New(sess.Copy(c.serviceConfig(ApplicationInsights))),
+		AppMeshConn:                       appmesh.New(sess.Copy(c.serviceConfig(AppMesh))),
+		AppRegistryConn:                   appregistry.New(sess.Copy(c.serviceConfig(AppRegistry))),
+		AppRunnerConn:                     apprunner.New(sess.Copy(c.serviceConfig(AppRunner))),
+		AppStreamConn:                     appstream.New(sess.Copy(c.serviceConfig(AppStream))),
+		AppSyncConn:                       appsync.New(sess.Copy(c.serviceConfig(AppSync))),
+		AthenaConn:                        athena.New(sess.Copy(c.serviceConfig(Athena))),
+		AuditManagerConn:                  auditmanager.New(sess.Copy(c.serviceConfig(AuditManager))),
+		AugmentedAIRuntimeConn:            augmentedairuntime.New(sess.Copy(c.serviceConfig(AugmentedAIRuntime))),
+		AutoScalingConn:                   autoscaling.New(sess.Copy(c.serviceConfig(AutoScaling))),
+		AutoScalingPlansConn:              autoscalingplans.New(sess.Copy(c.serviceConfig(AutoScalingPlans))),
+		BackupConn:                        backup.New(sess.Copy(c.serviceConfig(Backup))),
+		BatchConn:                         batch.New(sess.Copy(c.serviceConfig(Batch))),
+		BraketConn:                        braket.New(sess.Copy(c.serviceConfig(Braket))),
+		BudgetsConn:                       budgets.New(sess.Copy(c.serviceConfig(Budgets))),
+		ChimeConn:                         chime.New(sess.Copy(c.serviceConfig(Chime))),
+		Cloud9Conn:                        cloud9.New(sess.Copy(c.serviceConfig(Cloud9))),
+		CloudControlConn:                  cloudcontrolapi.New(sess.Copy(c.serviceConfig(CloudControl))),
+		CloudDirectoryConn:                clouddirectory.New(sess.Copy(c.serviceConfig(CloudDirectory))),
+		CloudFormationConn:                cloudformation.New(sess.Copy(c.serviceConfig(CloudFormation))),
+		CloudFrontConn:                    cloudfront.New(sess.Copy(c.serviceConfig(CloudFront))),
+		CloudHSMV2Conn:                    cloudhsmv2.New(sess.Copy(c.serviceConfig(CloudHSMV2))),
+		CloudSearchConn:                   cloudsearch.New(sess.Copy(c.serviceConfig(CloudSearch))),
+		CloudSearchDomainConn:             cloudsearchdomain.New(sess.Copy(c.serviceConfig(CloudSearchDomain))),
+		CloudTrailConn:                    cloudtrail.New(sess.Copy(c.serviceConfig(CloudTrail))),
+		CloudWatchConn:                    cloudwatch.New(sess.Copy(c.serviceConfig(CloudWatch))),
+		CloudWatchLogsConn:                cloudwatchlogs.New(sess.Copy(c.serviceConfig(CloudWatchLogs))),
+		CodeArtifactConn:                  codeartifact.New(sess.Copy(c.serviceConfig(CodeArtifact))),
+		CodeBuildConn:                     codebuild.New(sess.Copy(c.serviceConfig(CodeBuild))),
+		CodeCommitConn:                    codecommit.New(sess.Copy(c.serviceConfig(CodeCommit))),
+		CodeDeployConn:                    codedeploy.New(sess.Copy(c.serviceConfig(CodeDeploy))),
+		CodeGuruProfilerConn:              codeguruprofiler.New(sess.Copy(c.serviceConfig(CodeGuruProfiler))),
+		CodeGuruReviewerConn:              codegurureviewer.New(sess.Copy(c.serviceConfig(CodeGuruReviewer))),
+		CodePipelineConn:                  codepipeline.New(sess.Copy(c.serviceConfig(CodePipeline))),
+		CodeStarConn:                      codestar.New(sess.Copy(c.serviceConfig(CodeStar))),
+		CodeStarConnectionsConn:           codestarconnections.New(sess.Copy(c.serviceConfig(CodeStarConnections))),
+		CodeStarNotificationsConn:         codestarnotifications.New(sess.Copy(c.serviceConfig(CodeStarNotifications))),
+		CognitoIdentityConn:               cognitoidentity.New(sess.Copy(c.serviceConfig(CognitoIdentity))),
+		CognitoIDPConn:                    cognitoidentityprovider.New(sess.Copy(c.serviceConfig(CognitoIDP))),
+		CognitoSyncConn:                   cognitosync.New(sess.Copy(c.serviceConfig(CognitoSync))),
+		ComprehendConn:                    comprehend.New(sess.Copy(c.serviceConfig(Comprehend))),
+		ComprehendMedicalConn:             comprehendmedical.New(sess.Copy(c.serviceConfig(ComprehendMedical))),
+		ConfigServiceConn:                 configservice.New(sess.Copy(c.serviceConfig(ConfigService))),
+		ConnectConn:                       connect.New(sess.Copy(c.serviceConfig(Connect))),
+		ConnectContactLensConn:            connectcontactlens.New(sess.Copy(c.serviceConfig(ConnectContactLens))),
+		ConnectParticipantConn:            connectparticipant.New(sess.Copy(c.serviceConfig(ConnectParticipant))),
+		CostExplorerConn:                  costexplorer.New(sess.Copy(c.serviceConfig(CostExplorer))),
+		CURConn:                           costandusagereportservice.New(sess.Copy(c.serviceConfig(CUR))),
+		DataExchangeConn:                  dataexchange.New(sess.Copy(c.serviceConfig(DataExchange))),
+		DataPipelineConn:                  datapipeline.New(sess.Copy(c.serviceConfig(DataPipeline))),
+		DataSyncConn:                      datasync.New(sess.Copy(c.serviceConfig(DataSync))),
+		DAXConn:                           dax.New(sess.Copy(c.serviceConfig(DAX))),
 		DefaultTagsConfig:                 c.DefaultTagsConfig,
-		DetectiveConn:                     detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Detective])})),
-		DeviceFarmConn:                    devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DeviceFarm])})),
-		DevOpsGuruConn:                    devopsguru.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DevOpsGuru])})),
-		DirectConnectConn:                 directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DirectConnect])})),
-		DLMConn:                           dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DLM])})),
-		DMSConn:                           databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DMS])})),
+		DetectiveConn:                     detective.New(sess.Copy(c.serviceConfig(Detective))),
+		DeviceFarmConn:                    devicefarm.New(sess.Copy(c.serviceConfig(DeviceFarm))),
+		DevOpsGuruConn:                    devopsguru.New(sess.Copy(c.serviceConfig(DevOpsGuru))),
+		DirectConnectConn:                 directconnect.New(sess.Copy(c.serviceConfig(DirectConnect))),
+		DLMConn:                           dlm.New(sess.Copy(c.serviceConfig(DLM))),
+		DMSConn:                           databasemigrationservice.New(sess.Copy(c.serviceConfig(DMS))),
 		DNSSuffix:                         DNSSuffix,
-		DocDBConn:                         docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DocDB])})),
-		DSConn:                            directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DS])})),
-		DynamoDBConn:                      dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DynamoDB])})),
-		DynamoDBStreamsConn:               dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DynamoDBStreams])})),
-		EC2Conn:                           ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EC2])})),
-		EC2InstanceConnectConn:            ec2instanceconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EC2InstanceConnect])})),
-		ECRConn:                           ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ECR])})),
-		ECRPublicConn:                     ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ECRPublic])})),
-		ECSConn:                           ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ECS])})),
-		EFSConn:                           efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EFS])})),
-		EKSConn:                           eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EKS])})),
-		ElastiCacheConn:                   elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElastiCache])})),
-		ElasticBeanstalkConn:              elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElasticBeanstalk])})),
-		ElasticInferenceConn:              elasticinference.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElasticInference])})),
-		ElasticsearchConn:                 elasticsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Elasticsearch])})),
-		ElasticTranscoderConn:             elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElasticTranscoder])})),
-		ELBConn:                           elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ELB])})),
-		ELBV2Conn:                         elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ELBV2])})),
-		EMRConn:                           emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EMR])})),
-		EMRContainersConn:                 emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EMRContainers])})),
-		EventsConn:                        eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Events])})),
-		FinSpaceConn:                      finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FinSpace])})),
-		FinSpaceDataConn:                  finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FinSpaceData])})),
-		FirehoseConn:                      firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Firehose])})),
-		FISConn:                           fis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FIS])})),
-		FMSConn:                           fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FMS])})),
-		ForecastConn:                      forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Forecast])})),
-		ForecastQueryConn:                 forecastqueryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ForecastQuery])})),
-		FraudDetectorConn:                 frauddetector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FraudDetector])})),
-		FSxConn:                           fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FSx])})),
-		GameLiftConn:                      gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GameLift])})),
-		GlacierConn:                       glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Glacier])})),
-		GlueConn:                          glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Glue])})),
-		GlueDataBrewConn:                  gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GlueDataBrew])})),
-		GrafanaConn:                       managedgrafana.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Grafana])})),
-		GreengrassConn:                    greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Greengrass])})),
-		GreengrassV2Conn:                  greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GreengrassV2])})),
-		GroundStationConn:                 groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GroundStation])})),
-		GuardDutyConn:                     guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GuardDuty])})),
-		HealthConn:                        health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Health])})),
-		HealthLakeConn:                    healthlake.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[HealthLake])})),
-		HoneycodeConn:                     honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Honeycode])})),
-		IAMConn:                           iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IAM])})),
-		IdentityStoreConn:                 identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IdentityStore])})),
+		DocDBConn:                         docdb.New(sess.Copy(c.serviceConfig(DocDB))),
+		DSConn:                            directoryservice.New(sess.Copy(c.serviceConfig(DS))),
+		DynamoDBConn:                      dynamodb.New(sess.Copy(c.serviceConfig(DynamoDB))),
+		DynamoDBStreamsConn:               dynamodbstreams.New(sess.Copy(c.serviceConfig(DynamoDBStreams))),
+		EC2Conn:                           ec2.New(sess.Copy(c.serviceConfig(EC2))),
+		EC2InstanceConnectConn:            ec2instanceconnect.New(sess.Copy(c.serviceConfig(EC2InstanceConnect))),
+		ECRConn:                           ecr.New(sess.Copy(c.serviceConfig(ECR))),
+		ECRPublicConn:                     ecrpublic.New(sess.Copy(c.serviceConfig(ECRPublic))),
+		ECSConn:                           ecs.New(sess.Copy(c.serviceConfig(ECS))),
+		EFSConn:                           efs.New(sess.Copy(c.serviceConfig(EFS))),
+		EKSConn:                           eks.New(sess.Copy(c.serviceConfig(EKS))),
+		ElastiCacheConn:                   elasticache.New(sess.Copy(c.serviceConfig(ElastiCache))),
+		ElasticBeanstalkConn:              elasticbeanstalk.New(sess.Copy(c.serviceConfig(ElasticBeanstalk))),
+		ElasticInferenceConn:              elasticinference.New(sess.Copy(c.serviceConfig(ElasticInference))),
+		ElasticsearchConn:                 elasticsearch.New(sess.Copy(c.serviceConfig(Elasticsearch))),
+		ElasticTranscoderConn:             elastictranscoder.New(sess.Copy(c.serviceConfig(ElasticTranscoder))),
+		ELBConn:                           elb.New(sess.Copy(c.serviceConfig(ELB))),
+		ELBV2Conn:                         elbv2.New(sess.Copy(c.serviceConfig(ELBV2))),
+		EMRConn:                           emr.New(sess.Copy(c.serviceConfig(EMR))),
+		EMRContainersConn:                 emrcontainers.New(sess.Copy(c.serviceConfig(EMRContainers))),
+		EventsConn:                        eventbridge.New(sess.Copy(c.serviceConfig(Events))),
+		FinSpaceConn:                      finspace.New(sess.Copy(c.serviceConfig(FinSpace))),
+		FinSpaceDataConn:                  finspacedata.New(sess.Copy(c.serviceConfig(FinSpaceData))),
+		FirehoseConn:                      firehose.New(sess.Copy(c.serviceConfig(Firehose))),
+		FISConn:                           fis.New(sess.Copy(c.serviceConfig(FIS))),
+		FMSConn:                           fms.New(sess.Copy(c.serviceConfig(FMS))),
+		ForecastConn:                      forecastservice.New(sess.Copy(c.serviceConfig(Forecast))),
+		ForecastQueryConn:                 forecastqueryservice.New(sess.Copy(c.serviceConfig(ForecastQuery))),
+		FraudDetectorConn:                 frauddetector.New(sess.Copy(c.serviceConfig(FraudDetector))),
+		FSxConn:                           fsx.New(sess.Copy(c.serviceConfig(FSx))),
+		GameLiftConn:                      gamelift.New(sess.Copy(c.serviceConfig(GameLift))),
+		GlacierConn:                       glacier.New(sess.Copy(c.serviceConfig(Glacier))),
+		GlueConn:                          glue.New(sess.Copy(c.serviceConfig(Glue))),
+		GlueDataBrewConn:                  gluedatabrew.New(sess.Copy(c.serviceConfig(GlueDataBrew))),
+		GrafanaConn:                       managedgrafana.New(sess.Copy(c.serviceConfig(Grafana))),
+		GreengrassConn:                    greengrass.New(sess.Copy(c.serviceConfig(Greengrass))),
+		GreengrassV2Conn:                  greengrassv2.New(sess.Copy(c.serviceConfig(GreengrassV2))),
+		GroundStationConn:                 groundstation.New(sess.Copy(c.serviceConfig(GroundStation))),
+		GuardDutyConn:                     guardduty.New(sess.Copy(c.serviceConfig(GuardDuty))),
+		HealthConn:                        health.New(sess.Copy(c.serviceConfig(Health))),
+		HealthLakeConn:                    healthlake.New(sess.Copy(c.serviceConfig(HealthLake))),
+		HoneycodeConn:                     honeycode.New(sess.Copy(c.serviceConfig(Honeycode))),
+		IAMConn:                           iam.New(sess.Copy(c.serviceConfig(IAM))),
+		IdentityStoreConn:                 identitystore.New(sess.Copy(c.serviceConfig(IdentityStore))),
 		IgnoreTagsConfig:                  c.IgnoreTagsConfig,
-		ImageBuilderConn:                  imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ImageBuilder])})),
-		InspectorConn:                     inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Inspector])})),
-		IoT1ClickDevicesConn:              iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT1ClickDevices])})),
-		IoT1ClickProjectsConn:             iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT1ClickProjects])})),
-		IoTAnalyticsConn:                  iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTAnalytics])})),
-		IoTConn:                           iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT])})),
-		IoTDataPlaneConn:                  iotdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTDataPlane])})),
-		IoTDeviceAdvisorConn:              iotdeviceadvisor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTDeviceAdvisor])})),
-		IoTEventsConn:                     iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTEvents])})),
-		IoTEventsDataConn:                 ioteventsdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTEventsData])})),
-		IoTFleetHubConn:                   iotfleethub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTFleetHub])})),
-		IoTJobsDataPlaneConn:              iotjobsdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTJobsDataPlane])})),
-		IoTSecureTunnelingConn:            iotsecuretunneling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTSecureTunneling])})),
-		IoTSiteWiseConn:                   iotsitewise.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTSiteWise])})),
-		IoTThingsGraphConn:                iotthingsgraph.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTThingsGraph])})),
-		IoTWirelessConn:                   iotwireless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTWireless])})),
-		KafkaConn:                         kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Kafka])})),
-		KafkaConnectConn:                  kafkaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KafkaConnect])})),
-		KendraConn:                        kendra.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Kendra])})),
-		KinesisAnalyticsConn:              kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisAnalytics])})),
-		KinesisAnalyticsV2Conn:            kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisAnalyticsV2])})),
-		KinesisConn:                       kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Kinesis])})),
-		KinesisVideoArchivedMediaConn:     kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideoArchivedMedia])})),
-		KinesisVideoConn:                  kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideo])})),
-		KinesisVideoMediaConn:             kinesisvideomedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideoMedia])})),
-		KinesisVideoSignalingChannelsConn: kinesisvideosignalingchannels.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideoSignalingChannels])})),
-		KMSConn:                           kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KMS])})),
-		LakeFormationConn:                 lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LakeFormation])})),
-		LambdaConn:                        lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Lambda])})),
-		LexModelsConn:                     lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexModels])})),
-		LexModelsV2Conn:                   lexmodelsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexModelsV2])})),
-		LexRuntimeConn:                    lexruntimeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexRuntime])})),
-		LexRuntimeV2Conn:                  lexruntimev2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexRuntimeV2])})),
-		LicenseManagerConn:                licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LicenseManager])})),
-		LightsailConn:                     lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Lightsail])})),
-		LocationConn:                      locationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Location])})),
-		LookoutEquipmentConn:              lookoutequipment.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutEquipment])})),
-		LookoutForVisionConn:              lookoutforvision.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutForVision])})),
-		LookoutMetricsConn:                lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutMetrics])})),
-		MachineLearningConn:               machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MachineLearning])})),
-		Macie2Conn:                        macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Macie2])})),
-		MacieConn:                         macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Macie])})),
-		ManagedBlockchainConn:             managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ManagedBlockchain])})),
-		MarketplaceCatalogConn:            marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceCatalog])})),
-		MarketplaceCommerceAnalyticsConn:  marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceCommerceAnalytics])})),
-		MarketplaceEntitlementConn:        marketplaceentitlementservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceEntitlement])})),
-		MarketplaceMeteringConn:           marketplacemetering.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceMetering])})),
-		MediaConnectConn:                  mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaConnect])})),
-		MediaConvertConn:                  mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaConvert])})),
-		MediaLiveConn:                     medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaLive])})),
-		MediaPackageConn:                  mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaPackage])})),
-		MediaPackageVODConn:               mediapackagevod.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaPackageVOD])})),
-		MediaStoreConn:                    mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaStore])})),
-		MediaStoreDataConn:                mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaStoreData])})),
-		MediaTailorConn:                   mediatailor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaTailor])})),
-		MemoryDBConn:                      memorydb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MemoryDB])})),
-		MgnConn:                           mgn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Mgn])})),
-		MigrationHubConfigConn:            migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MigrationHubConfig])})),
-		MigrationHubConn:                  migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MigrationHub])})),
-		MobileAnalyticsConn:               mobileanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MobileAnalytics])})),
-		MobileConn:                        mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Mobile])})),
-		MQConn:                            mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MQ])})),
-		MTurkConn:                         mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MTurk])})),
-		MWAAConn:                          mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MWAA])})),
-		NeptuneConn:                       neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Neptune])})),
-		NetworkFirewallConn:               networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkFirewall])})),
-		NetworkManagerConn:                networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkManager])})),
-		NimbleStudioConn:                  nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NimbleStudio])})),
-		OpsWorksCMConn:                    opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorksCM])})),
-		OpsWorksConn:                      opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorks])})),
-		OrganizationsConn:                 organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Organizations])})),
-		OutpostsConn:                      outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Outposts])})),
+		ImageBuilderConn:                  imagebuilder.New(sess.Copy(c.serviceConfig(ImageBuilder))),
+		InspectorConn:                     inspector.New(sess.Copy(c.serviceConfig(Inspector))),
+		IoT1ClickDevicesConn:              iot1clickdevicesservice.New(sess.Copy(c.serviceConfig(IoT1ClickDevices))),
+		IoT1ClickProjectsConn:             iot1clickprojects.New(sess.Copy(c.serviceConfig(IoT1ClickProjects))),
+		IoTAnalyticsConn:                  iotanalytics.New(sess.Copy(c.serviceConfig(IoTAnalytics))),
+		IoTConn:                           iot.New(sess.Copy(c.serviceConfig(IoT))),
+		IoTDataPlaneConn:                  iotdataplane.New(sess.Copy(c.serviceConfig(IoTDataPlane))),
+		IoTDeviceAdvisorConn:              iotdeviceadvisor.New(sess.Copy(c.serviceConfig(IoTDeviceAdvisor))),
+		IoTEventsConn:                     iotevents.New(sess.Copy(c.serviceConfig(IoTEvents))),
+		IoTEventsDataConn:                 ioteventsdata.New(sess.Copy(c.serviceConfig(IoTEventsData))),
+		IoTFleetHubConn:                   iotfleethub.New(sess.Copy(c.serviceConfig(IoTFleetHub))),
+		IoTJobsDataPlaneConn:              iotjobsdataplane.New(sess.Copy(c.serviceConfig(IoTJobsDataPlane))),
+		IoTSecureTunnelingConn:            iotsecuretunneling.New(sess.Copy(c.serviceConfig(IoTSecureTunneling))),
+		IoTSiteWiseConn:                   iotsitewise.New(sess.Copy(c.serviceConfig(IoTSiteWise))),
+		IoTThingsGraphConn:                iotthingsgraph.New(sess.Copy(c.serviceConfig(IoTThingsGraph))),
+		IoTWirelessConn:                   iotwireless.New(sess.Copy(c.serviceConfig(IoTWireless))),
+		KafkaConn:                         kafka.New(sess.Copy(c.serviceConfig(Kafka))),
+		KafkaConnectConn:                  kafkaconnect.New(sess.Copy(c.serviceConfig(KafkaConnect))),
+		KendraConn:                        kendra.New(sess.Copy(c.serviceConfig(Kendra))),
+		KinesisAnalyticsConn:              kinesisanalytics.New(sess.Copy(c.serviceConfig(KinesisAnalytics))),
+		KinesisAnalyticsV2Conn:            kinesisanalyticsv2.New(sess.Copy(c.serviceConfig(KinesisAnalyticsV2))),
+		KinesisConn:                       kinesis.New(sess.Copy(c.serviceConfig(Kinesis))),
+		KinesisVideoArchivedMediaConn:     kinesisvideoarchivedmedia.New(sess.Copy(c.serviceConfig(KinesisVideoArchivedMedia))),
+		KinesisVideoConn:                  kinesisvideo.New(sess.Copy(c.serviceConfig(KinesisVideo))),
+		KinesisVideoMediaConn:             kinesisvideomedia.New(sess.Copy(c.serviceConfig(KinesisVideoMedia))),
+		KinesisVideoSignalingChannelsConn: kinesisvideosignalingchannels.New(sess.Copy(c.serviceConfig(KinesisVideoSignalingChannels))),
+		KMSConn:                           kms.New(sess.Copy(c.serviceConfig(KMS))),
+		LakeFormationConn:                 lakeformation.New(sess.Copy(c.serviceConfig(LakeFormation))),
+		LambdaConn:                        lambda.New(sess.Copy(c.serviceConfig(Lambda))),
+		LexModelsConn:                     lexmodelbuildingservice.New(sess.Copy(c.serviceConfig(LexModels))),
+		LexModelsV2Conn:                   lexmodelsv2.New(sess.Copy(c.serviceConfig(LexModelsV2))),
+		LexRuntimeConn:                    lexruntimeservice.New(sess.Copy(c.serviceConfig(LexRuntime))),
+		LexRuntimeV2Conn:                  lexruntimev2.New(sess.Copy(c.serviceConfig(LexRuntimeV2))),
+		LicenseManagerConn:                licensemanager.New(sess.Copy(c.serviceConfig(LicenseManager))),
+		LightsailConn:                     lightsail.New(sess.Copy(c.serviceConfig(Lightsail))),
+		LocationConn:                      locationservice.New(sess.Copy(c.serviceConfig(Location))),
+		LookoutEquipmentConn:              lookoutequipment.New(sess.Copy(c.serviceConfig(LookoutEquipment))),
+		LookoutForVisionConn:              lookoutforvision.New(sess.Copy(c.serviceConfig(LookoutForVision))),
+		LookoutMetricsConn:                lookoutmetrics.New(sess.Copy(c.serviceConfig(LookoutMetrics))),
+		MachineLearningConn:               machinelearning.New(sess.Copy(c.serviceConfig(MachineLearning))),
+		Macie2Conn:                        macie2.New(sess.Copy(c.serviceConfig(Macie2))),
+		MacieConn:                         macie.New(sess.Copy(c.serviceConfig(Macie))),
+		ManagedBlockchainConn:             managedblockchain.New(sess.Copy(c.serviceConfig(ManagedBlockchain))),
+		MarketplaceCatalogConn:            marketplacecatalog.New(sess.Copy(c.serviceConfig(MarketplaceCatalog))),
+		MarketplaceCommerceAnalyticsConn:  marketplacecommerceanalytics.New(sess.Copy(c.serviceConfig(MarketplaceCommerceAnalytics))),
+		MarketplaceEntitlementConn:        marketplaceentitlementservice.New(sess.Copy(c.serviceConfig(MarketplaceEntitlement))),
+		MarketplaceMeteringConn:           marketplacemetering.New(sess.Copy(c.serviceConfig(MarketplaceMetering))),
+		MediaConnectConn:                  mediaconnect.New(sess.Copy(c.serviceConfig(MediaConnect))),
+		MediaConvertConn:                  mediaconvert.New(sess.Copy(c.serviceConfig(MediaConvert))),
+		MediaLiveConn:                     medialive.New(sess.Copy(c.serviceConfig(MediaLive))),
+		MediaPackageConn:                  mediapackage.New(sess.Copy(c.serviceConfig(MediaPackage))),
+		MediaPackageVODConn:               mediapackagevod.New(sess.Copy(c.serviceConfig(MediaPackageVOD))),
+		MediaStoreConn:                    mediastore.New(sess.Copy(c.serviceConfig(MediaStore))),
+		MediaStoreDataConn:                mediastoredata.New(sess.Copy(c.serviceConfig(MediaStoreData))),
+		MediaTailorConn:                   mediatailor.New(sess.Copy(c.serviceConfig(MediaTailor))),
+		MemoryDBConn:                      memorydb.New(sess.Copy(c.serviceConfig(MemoryDB))),
+		MgnConn:                           mgn.New(sess.Copy(c.serviceConfig(Mgn))),
+		MigrationHubConfigConn:            migrationhubconfig.New(sess.Copy(c.serviceConfig(MigrationHubConfig))),
+		MigrationHubConn:                  migrationhub.New(sess.Copy(c.serviceConfig(MigrationHub))),
+		MobileAnalyticsConn:               mobileanalytics.New(sess.Copy(c.serviceConfig(MobileAnalytics))),
+		MobileConn:                        mobile.New(sess.Copy(c.serviceConfig(Mobile))),
+		MQConn:                            mq.New(sess.Copy(c.serviceConfig(MQ))),
+		MTurkConn:                         mturk.New(sess.Copy(c.serviceConfig(MTurk))),
+		MWAAConn:                          mwaa.New(sess.Copy(c.serviceConfig(MWAA))),
+		NeptuneConn:                       neptune.New(sess.Copy(c.serviceConfig(Neptune))),
+		NetworkFirewallConn:               networkfirewall.New(sess.Copy(c.serviceConfig(NetworkFirewall))),
+		NetworkManagerConn:                networkmanager.New(sess.Copy(c.serviceConfig(NetworkManager))),
+		NimbleStudioConn:                  nimblestudio.New(sess.Copy(c.serviceConfig(NimbleStudio))),
+		OpsWorksCMConn:                    opsworkscm.New(sess.Copy(c.serviceConfig(OpsWorksCM))),
+		OpsWorksConn:                      opsworks.New(sess.Copy(c.serviceConfig(OpsWorks))),
+		OrganizationsConn:                 organizations.New(sess.Copy(c.serviceConfig(Organizations))),
+		OutpostsConn:                      outposts.New(sess.Copy(c.serviceConfig(Outposts))),
 		Partition:                         Partition,
-		PersonalizeConn:                   personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Personalize])})),
-		PersonalizeEventsConn:             personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PersonalizeEvents])})),
-		PersonalizeRuntimeConn:            personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PersonalizeRuntime])})),
-		PIConn:                            pi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PI])})),
-		PinpointConn:                      pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pinpoint])})),
-		PinpointEmailConn:                 pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PinpointEmail])})),
-		PinpointSMSVoiceConn:              pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PinpointSMSVoice])})),
-		PollyConn:                         polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Polly])})),
-		PricingConn:                       pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pricing])})),
-		ProtonConn:                        proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Proton])})),
-		QLDBConn:                          qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QLDB])})),
-		QLDBSessionConn:                   qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QLDBSession])})),
-		QuickSightConn:                    quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QuickSight])})),
-		RAMConn:                           ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RAM])})),
-		RDSConn:                           rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RDS])})),
-		RDSDataConn:                       rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RDSData])})),
-		RedshiftConn:                      redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Redshift])})),
-		RedshiftDataConn:                  redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RedshiftData])})),
+		PersonalizeConn:                   personalize.New(sess.Copy(c.serviceConfig(Personalize))),
+		PersonalizeEventsConn:             personalizeevents.New(sess.Copy(c.serviceConfig(PersonalizeEvents))),
+		PersonalizeRuntimeConn:            personalizeruntime.New(sess.Copy(c.serviceConfig(PersonalizeRuntime))),
+		PIConn:                            pi.New(sess.Copy(c.serviceConfig(PI))),
+		PinpointConn:                      pinpoint.New(sess.Copy(c.serviceConfig(Pinpoint))),
+		PinpointEmailConn:                 pinpointemail.New(sess.Copy(c.serviceConfig(PinpointEmail))),
+		PinpointSMSVoiceConn:              pinpointsmsvoice.New(sess.Copy(c.serviceConfig(PinpointSMSVoice))),
+		PollyConn:                         polly.New(sess.Copy(c.serviceConfig(Polly))),
+		PricingConn:                       pricing.New(sess.Copy(c.serviceConfig(Pricing))),
+		ProtonConn:                        proton.New(sess.Copy(c.serviceConfig(Proton))),
+		QLDBConn:                          qldb.New(sess.Copy(c.serviceConfig(QLDB))),
+		QLDBSessionConn:                   qldbsession.New(sess.Copy(c.serviceConfig(QLDBSession))),
+		QuickSightConn:                    quicksight.New(sess.Copy(c.serviceConfig(QuickSight))),
+		RAMConn:                           ram.New(sess.Copy(c.serviceConfig(RAM))),
+		RDSConn:                           rds.New(sess.Copy(c.serviceConfig(RDS))),
+		RDSDataConn:                       rdsdataservice.New(sess.Copy(c.serviceConfig(RDSData))),
+		ReadOnly:                          c.ReadOnly,
+		RedshiftConn:                      redshift.New(sess.Copy(c.serviceConfig(Redshift))),
+		RedshiftDataConn:                  redshiftdataapiservice.New(sess.Copy(c.serviceConfig(RedshiftData))),
 		Region:                            c.Region,
-		RekognitionConn:                   rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Rekognition])})),
-		ResourceGroupsConn:                resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroups])})),
-		ResourceGroupsTaggingAPIConn:      resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroupsTaggingAPI])})),
+		RekognitionConn:                   rekognition.New(sess.Copy(c.serviceConfig(Rekognition))),
+		ResourceGroupsConn:                resourcegroups.New(sess.Copy(c.serviceConfig(ResourceGroups))),
+		ResourceGroupsTaggingAPIConn:      resourcegroupstaggingapi.New(sess.Copy(c.serviceConfig(ResourceGroupsTaggingAPI))),
 		ReverseDNSPrefix:                  ReverseDNS(DNSSuffix),
-		RoboMakerConn:                     robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RoboMaker])})),
-		Route53DomainsConn:                route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Domains])})),
-		Route53RecoveryControlConfigConn:  route53recoverycontrolconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53RecoveryControlConfig])})),
-		Route53RecoveryReadinessConn:      route53recoveryreadiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53RecoveryReadiness])})),
-		Route53ResolverConn:               route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Resolver])})),
-		S3ControlConn:                     s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[S3Control])})),
-		S3OutpostsConn:                    s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[S3Outposts])})),
-		SageMakerConn:                     sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMaker])})),
-		SageMakerEdgeManagerConn:          sagemakeredgemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerEdgeManager])})),
-		SageMakerFeatureStoreRuntimeConn:  sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerFeatureStoreRuntime])})),
-		SageMakerRuntimeConn:              sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerRuntime])})),
-		SavingsPlansConn:                  savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SavingsPlans])})),
-		SchemasConn:                       schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Schemas])})),
-		SecretsManagerConn:                secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecretsManager])})),
-		SecurityHubConn:                   securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecurityHub])})),
-		ServerlessRepoConn:                serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServerlessRepo])})),
-		ServiceCatalogConn:                servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceCatalog])})),
-		ServiceDiscoveryConn:              servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceDiscovery])})),
-		ServiceQuotasConn:                 servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceQuotas])})),
-		SESConn:                           ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SES])})),
-		SESV2Conn:                         sesv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SESV2])})),
-		SFNConn:                           sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SFN])})),
-		SignerConn:                        signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Signer])})),
-		SimpleDBConn:                      simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SimpleDB])})),
-		SMSConn:                           sms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SMS])})),
-		SnowballConn:                      snowball.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Snowball])})),
-		SNSConn:                           sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SNS])})),
-		SQSConn:                           sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SQS])})),
-		SSMConn:                           ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSM])})),
-		SSMContactsConn:                   ssmcontacts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSMContacts])})),
-		SSMIncidentsConn:                  ssmincidents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSMIncidents])})),
-		SSOAdminConn:                      ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSOAdmin])})),
-		SSOConn:                           sso.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSO])})),
-		SSOOIDCConn:                       ssooidc.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSOOIDC])})),
-		StorageGatewayConn:                storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[StorageGateway])})),
-		STSConn:                           sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[STS])})),
-		SupportConn:                       support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Support])})),
-		SWFConn:                           swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SWF])})),
-		SyntheticsConn:                    synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Synthetics])})),
+		RoboMakerConn:                     robomaker.New(sess.Copy(c.serviceConfig(RoboMaker))),
+		Route53DomainsConn:                route53domains.New(sess.Copy(c.serviceConfig(Route53Domains))),
+		Route53RecoveryControlConfigConn:  route53recoverycontrolconfig.New(sess.Copy(c.serviceConfig(Route53RecoveryControlConfig))),
+		Route53RecoveryReadinessConn:      route53recoveryreadiness.New(sess.Copy(c.serviceConfig(Route53RecoveryReadiness))),
+		Route53ResolverConn:               route53resolver.New(sess.Copy(c.serviceConfig(Route53Resolver))),
+		S3ControlConn:                     s3control.New(sess.Copy(c.serviceConfig(S3Control))),
+		S3OutpostsConn:                    s3outposts.New(sess.Copy(c.serviceConfig(S3Outposts))),
+		SageMakerConn:                     sagemaker.New(sess.Copy(c.serviceConfig(SageMaker))),
+		SageMakerEdgeManagerConn:          sagemakeredgemanager.New(sess.Copy(c.serviceConfig(SageMakerEdgeManager))),
+		SageMakerFeatureStoreRuntimeConn:  sagemakerfeaturestoreruntime.New(sess.Copy(c.serviceConfig(SageMakerFeatureStoreRuntime))),
+		SageMakerRuntimeConn:              sagemakerruntime.New(sess.Copy(c.serviceConfig(SageMakerRuntime))),
+		SavingsPlansConn:                  savingsplans.New(sess.Copy(c.serviceConfig(SavingsPlans))),
+		SchemasConn:                       schemas.New(sess.Copy(c.serviceConfig(Schemas))),
+		SecretsManagerConn:                secretsmanager.New(sess.Copy(c.serviceConfig(SecretsManager))),
+		SecurityHubConn:                   securityhub.New(sess.Copy(c.serviceConfig(SecurityHub))),
+		ServerlessRepoConn:                serverlessapplicationrepository.New(sess.Copy(c.serviceConfig(ServerlessRepo))),
+		ServiceCatalogConn:                servicecatalog.New(sess.Copy(c.serviceConfig(ServiceCatalog))),
+		ServiceDiscoveryConn:              servicediscovery.New(sess.Copy(c.serviceConfig(ServiceDiscovery))),
+		ServiceQuotasConn:                 servicequotas.New(sess.Copy(c.serviceConfig(ServiceQuotas))),
+		SESConn:                           ses.New(sess.Copy(c.serviceConfig(SES))),
+		SESV2Conn:                         sesv2.New(sess.Copy(c.serviceConfig(SESV2))),
+		SFNConn:                           sfn.New(sess.Copy(c.serviceConfig(SFN))),
+		SignerConn:                        signer.New(sess.Copy(c.serviceConfig(Signer))),
+		SimpleDBConn:                      simpledb.New(sess.Copy(c.serviceConfig(SimpleDB))),
+		SMSConn:                           sms.New(sess.Copy(c.serviceConfig(SMS))),
+		SnowballConn:                      snowball.New(sess.Copy(c.serviceConfig(Snowball))),
+		SNSConn:                           sns.New(sess.Copy(c.serviceConfig(SNS))),
+		SQSConn:                           sqs.New(sess.Copy(c.serviceConfig(SQS))),
+		SSMConn:                           ssm.New(sess.Copy(c.serviceConfig(SSM))),
+		SSMContactsConn:                   ssmcontacts.New(sess.Copy(c.serviceConfig(SSMContacts))),
+		SSMIncidentsConn:                  ssmincidents.New(sess.Copy(c.serviceConfig(SSMIncidents))),
+		SSOAdminConn:                      ssoadmin.New(sess.Copy(c.serviceConfig(SSOAdmin))),
+		SSOConn:                           sso.New(sess.Copy(c.serviceConfig(SSO))),
+		SSOOIDCConn:                       ssooidc.New(sess.Copy(c.serviceConfig(SSOOIDC))),
+		StorageGatewayConn:                storagegateway.New(sess.Copy(c.serviceConfig(StorageGateway))),
+		STSConn:                           sts.New(sess.Copy(c.serviceConfig(STS))),
+		SupportConn:                       support.New(sess.Copy(c.serviceConfig(Support))),
+		SWFConn:                           swf.New(sess.Copy(c.serviceConfig(SWF))),
+		SyntheticsConn:                    synthetics.New(sess.Copy(c.serviceConfig(Synthetics))),
 		TerraformVersion:                  c.TerraformVersion,
-		TextractConn:                      textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Textract])})),
-		TimestreamQueryConn:               timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TimestreamQuery])})),
-		TimestreamWriteConn:               timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TimestreamWrite])})),
-		TranscribeConn:                    transcribeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Transcribe])})),
-		TranscribeStreamingConn:           transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TranscribeStreaming])})),
-		TransferConn:                      transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Transfer])})),
-		TranslateConn:                     translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Translate])})),
-		WAFConn:                           waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAF])})),
-		WAFRegionalConn:                   wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAFRegional])})),
-		WAFV2Conn:                         wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAFV2])})),
-		WellArchitectedConn:               wellarchitected.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WellArchitected])})),
-		WorkDocsConn:                      workdocs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkDocs])})),
-		WorkLinkConn:                      worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkLink])})),
-		WorkMailConn:                      workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkMail])})),
-		WorkMailMessageFlowConn:           workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkMailMessageFlow])})),
-		WorkSpacesConn:                    workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkSpaces])})),
-		XRayConn:                          xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[XRay])})),
+		TextractConn:                      textract.New(sess.Copy(c.serviceConfig(Textract))),
+		TimestreamQueryConn:               timestreamquery.New(sess.Copy(c.serviceConfig(TimestreamQuery))),
+		TimestreamWriteConn:               timestreamwrite.New(sess.Copy(c.serviceConfig(TimestreamWrite))),
+		TranscribeConn:                    transcribeservice.New(sess.Copy(c.serviceConfig(Transcribe))),
+		TranscribeStreamingConn:           transcribestreamingservice.New(sess.Copy(c.serviceConfig(TranscribeStreaming))),
+		TransferConn:                      transfer.New(sess.Copy(c.serviceConfig(Transfer))),
+		TranslateConn:                     translate.New(sess.Copy(c.serviceConfig(Translate))),
+		UserAgent:                         c.UserAgent,
+		WAFConn:                           waf.New(sess.Copy(c.serviceConfig(WAF))),
+		WAFRegionalConn:                   wafregional.New(sess.Copy(c.serviceConfig(WAFRegional))),
+		WAFV2Conn:                         wafv2.New(sess.Copy(c.serviceConfig(WAFV2))),
+		WellArchitectedConn:               wellarchitected.New(sess.Copy(c.serviceConfig(WellArchitected))),
+		WisdomConn:                        connectwisdomservice.New(sess.Copy(c.serviceConfig(Wisdom))),
+		WorkDocsConn:                      workdocs.New(sess.Copy(c.serviceConfig(WorkDocs))),
+		WorkLinkConn:                      worklink.New(sess.Copy(c.serviceConfig(WorkLink))),
+		WorkMailConn:                      workmail.New(sess.Copy(c.serviceConfig(WorkMail))),
+		WorkMailMessageFlowConn:           workmailmessageflow.New(sess.Copy(c.serviceConfig(WorkMailMessageFlow))),
+		WorkSpacesConn:                    workspaces.New(sess.Copy(c.serviceConfig(WorkSpaces))),
+		WorkSpacesWebConn:                 workspacesweb.New(sess.Copy(c.serviceConfig(WorkSpacesWeb))),
+		XRayConn:                          xray.New(sess.Copy(c.serviceConfig(XRay))),
 	}
 
 	// "Global" services that require customizations
-	globalAcceleratorConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[GlobalAccelerator]),
-	}
-	route53Config := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Route53]),
-	}
-	route53RecoveryControlConfigConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Route53RecoveryControlConfig]),
-	}
-	route53RecoveryReadinessConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Route53RecoveryReadiness]),
-	}
-	shieldConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Shield]),
-	}
+	globalAcceleratorConfig := c.serviceConfig(GlobalAccelerator)
+	route53Config := c.serviceConfig(Route53)
+	route53RecoveryControlConfigConfig := c.serviceConfig(Route53RecoveryControlConfig)
+	route53RecoveryReadinessConfig := c.serviceConfig(Route53RecoveryReadiness)
+	shieldConfig := c.serviceConfig(Shield)
 
 	// Services that require multiple client configurations
-	s3Config := &aws.Config{
-		Endpoint:         aws.String(c.Endpoints[S3]),
-		S3ForcePathStyle: aws.Bool(c.S3UsePathStyle),
-	}
+	s3Config := c.serviceConfig(S3)
+	s3Config.S3ForcePathStyle = aws.Bool(c.S3UsePathStyle)
 
 	client.S3Conn = s3.New(sess.Copy(s3Config))
 
@@ -1869,16 +1928,22 @@ func StdUserAgentProducts(terraformVersion string) *awsbase.APNInfo {
 	}
 }
 
-func NewSessionForRegion(cfg *aws.Config, region, terraformVersion string) (*session.Session, error) {
+// NewSessionForRegion returns a new AWS SDK for Go v1 session for the specified region
+// using the client's user agent and read_only settings.
+func (client *AWSClient) NewSessionForRegion(cfg *aws.Config, region string) (*session.Session, error) {
 	session, err := session.NewSession(cfg)
 
 	if err != nil {
 		return nil, err
 	}
 
-	apnInfo := StdUserAgentProducts(terraformVersion)
+	apnInfo := StdUserAgentProducts(client.TerraformVersion)
+
+	awsbasev1.SetSessionUserAgent(session, apnInfo, client.UserAgent)
 
-	awsbasev1.SetSessionUserAgent(session, apnInfo, awsbase.UserAgentProducts{})
+	if client.ReadOnly {
+		session.Handlers.Validate.PushFrontNamed(ReadOnlyHandler)
+	}
 
 	return session.Copy(&aws.Config{Region: aws.String(region)}), nil
 }
@@ -2,9 +2,15 @@ package conns
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/kms"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	mockdatav1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/mockdata"
 	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
 )
@@ -83,6 +89,105 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestConfigServiceConfig(t *testing.T) {
+	testCases := []struct {
+		Name                         string
+		Config                       *Config
+		Service                      string
+		ExpectedUseDualStackEndpoint endpoints.DualStackEndpointState
+		ExpectedUseFIPSEndpoint      endpoints.FIPSEndpointState
+	}{
+		{
+			Name:                         "no overrides",
+			Config:                       &Config{},
+			Service:                      EC2,
+			ExpectedUseDualStackEndpoint: endpoints.DualStackEndpointStateUnset,
+			ExpectedUseFIPSEndpoint:      endpoints.FIPSEndpointStateUnset,
+		},
+		{
+			Name: "other service overrides",
+			Config: &Config{
+				UseDualStackEndpointOverrides: map[string]bool{S3: true},
+				UseFIPSEndpointOverrides:      map[string]bool{S3: true},
+			},
+			Service:                      EC2,
+			ExpectedUseDualStackEndpoint: endpoints.DualStackEndpointStateUnset,
+			ExpectedUseFIPSEndpoint:      endpoints.FIPSEndpointStateUnset,
+		},
+		{
+			Name: "enabled",
+			Config: &Config{
+				UseDualStackEndpointOverrides: map[string]bool{EC2: true},
+				UseFIPSEndpointOverrides:      map[string]bool{EC2: true},
+			},
+			Service:                      EC2,
+			ExpectedUseDualStackEndpoint: endpoints.DualStackEndpointStateEnabled,
+			ExpectedUseFIPSEndpoint:      endpoints.FIPSEndpointStateEnabled,
+		},
+		{
+			Name: "disabled",
+			Config: &Config{
+				UseDualStackEndpoint:          true,
+				UseDualStackEndpointOverrides: map[string]bool{EC2: false},
+				UseFIPSEndpoint:               true,
+				UseFIPSEndpointOverrides:      map[string]bool{EC2: false},
+			},
+			Service:                      EC2,
+			ExpectedUseDualStackEndpoint: endpoints.DualStackEndpointStateDisabled,
+			ExpectedUseFIPSEndpoint:      endpoints.FIPSEndpointStateDisabled,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.Config.serviceConfig(testCase.Service)
+
+			if got.UseDualStackEndpoint != testCase.ExpectedUseDualStackEndpoint {
+				t.Errorf("got UseDualStackEndpoint %d, expected %d", got.UseDualStackEndpoint, testCase.ExpectedUseDualStackEndpoint)
+			}
+
+			if got.UseFIPSEndpoint != testCase.ExpectedUseFIPSEndpoint {
+				t.Errorf("got UseFIPSEndpoint %d, expected %d", got.UseFIPSEndpoint, testCase.ExpectedUseFIPSEndpoint)
+			}
+		})
+	}
+}
+
+func TestAWSClientNewSessionForRegion_userAgent(t *testing.T) {
+	client := &AWSClient{
+		TerraformVersion: "1.0.0",
+		UserAgent: awsbase.UserAgentProducts{
+			{Name: "first", Version: "1.2.3"},
+			{Name: "second", Version: "4.5.6"},
+		},
+	}
+
+	cfg := &aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String("us-east-1"),
+	}
+
+	sess, err := client.NewSessionForRegion(cfg, "us-west-2")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	req, _ := kms.New(sess).DescribeKeyRequest(&kms.DescribeKeyInput{KeyId: aws.String("alias/test")})
+
+	if err := req.Build(); err != nil {
+		t.Fatalf("unexpected error building request: %s", err)
+	}
+
+	userAgent := req.HTTPRequest.Header.Get("User-Agent")
+
+	for _, expected := range []string{"Terraform/1.0.0", "first/1.2.3", "second/4.5.6"} {
+		if !strings.Contains(userAgent, expected) {
+			t.Errorf("expected User-Agent %q to contain %q", userAgent, expected)
+		}
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*servicemocks.MockEndpoint{
 		{
@@ -0,0 +1,214 @@
+package conns
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const redactedValue = "[REDACTED]"
+
+// defaultRedactedHeaders are always redacted from logged requests, in addition to any configured names.
+var defaultRedactedHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+}
+
+// defaultRedactedFields are always redacted from logged bodies, in addition to any configured names.
+// Field names are matched case-insensitively, covering APIs such as SSO that use camel case.
+var defaultRedactedFields = []string{
+	"SecretAccessKey",
+	"SessionToken",
+}
+
+// DebugLoggingConfig configures protocol-level logging of API requests and responses.
+type DebugLoggingConfig struct {
+	// HTTPBodies enables logging of textual request and response bodies.
+	HTTPBodies bool
+	// Redact lists header names and body field names whose values are replaced before logging.
+	Redact []string
+}
+
+// loggingTransport is an http.RoundTripper that logs requests and responses, redacting sensitive values.
+type loggingTransport struct {
+	config    *DebugLoggingConfig
+	headers   map[string]bool
+	redactors []bodyRedactor
+	transport http.RoundTripper
+}
+
+type bodyRedactor struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+// NewLoggingTransport returns an http.RoundTripper that logs requests and responses made through `transport`.
+func NewLoggingTransport(config *DebugLoggingConfig, transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	t := &loggingTransport{
+		config:    config,
+		headers:   make(map[string]bool),
+		transport: transport,
+	}
+
+	for _, v := range append(defaultRedactedHeaders, config.Redact...) {
+		t.headers[http.CanonicalHeaderKey(v)] = true
+	}
+
+	for _, v := range append(defaultRedactedFields, config.Redact...) {
+		name := `(?i:` + regexp.QuoteMeta(v) + `)`
+		t.redactors = append(t.redactors,
+			// JSON protocols.
+			bodyRedactor{regexp.MustCompile(`("` + name + `"\s*:\s*)"(?:[^"\\]|\\.)*"`), `${1}"` + redactedValue + `"`},
+			// XML protocols.
+			bodyRedactor{regexp.MustCompile(`(<` + name + `>)[^<]*(</` + name + `>)`), `${1}` + redactedValue + `${2}`},
+			// Query protocols.
+			bodyRedactor{regexp.MustCompile(`((?:^|&)` + name + `=)[^&]*`), `${1}` + redactedValue},
+		)
+	}
+
+	return t
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	log.Printf("[DEBUG] HTTP Request Sent: %s", t.formatRequest(req))
+
+	resp, err := t.transport.RoundTrip(req)
+
+	if err != nil {
+		log.Printf("[DEBUG] HTTP Request Error (%s %s): %s", req.Method, req.URL.Redacted(), err)
+		return resp, err
+	}
+
+	log.Printf("[DEBUG] HTTP Response Received: %s", t.formatResponse(resp))
+
+	return resp, nil
+}
+
+func (t *loggingTransport) formatRequest(req *http.Request) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s %s", req.Method, req.URL.Redacted())
+	t.writeHeaders(&b, req.Header)
+
+	if t.config.HTTPBodies && req.GetBody != nil && isLoggedContent(req, req.Header) {
+		body, err := req.GetBody()
+
+		if err == nil {
+			defer body.Close()
+
+			if v, err := io.ReadAll(body); err == nil {
+				t.writeBody(&b, v)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func (t *loggingTransport) formatResponse(resp *http.Response) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s", resp.Status)
+	t.writeHeaders(&b, resp.Header)
+
+	if t.config.HTTPBodies && resp.Body != nil && isLoggedContent(resp.Request, resp.Header) {
+		v, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(v))
+
+		if err == nil {
+			t.writeBody(&b, v)
+		}
+	}
+
+	return b.String()
+}
+
+func (t *loggingTransport) writeHeaders(b *strings.Builder, header http.Header) {
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := strings.Join(header[k], ", ")
+
+		if t.headers[http.CanonicalHeaderKey(k)] {
+			v = redactedValue
+		}
+
+		fmt.Fprintf(b, "\n%s: %s", k, v)
+	}
+}
+
+func (t *loggingTransport) writeBody(b *strings.Builder, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "\n\n%s", t.redactBody(string(body)))
+}
+
+func (t *loggingTransport) redactBody(body string) string {
+	for _, r := range t.redactors {
+		body = r.re.ReplaceAllString(body, r.replacement)
+	}
+
+	return body
+}
+
+// isLoggedContent returns whether a request or response body is logged.
+// Only JSON, XML and AWS Query protocol (form-encoded) payloads are logged, and S3 object contents never are.
+func isLoggedContent(req *http.Request, header http.Header) bool {
+	if req != nil && isS3ObjectRequest(req) {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case strings.Contains(mediaType, "json"),
+		strings.Contains(mediaType, "xml"),
+		mediaType == "application/x-www-form-urlencoded":
+		return true
+	}
+
+	return false
+}
+
+// isS3ObjectRequest returns whether the request is signed for S3 and addresses an object key
+// rather than the service or a bucket.
+func isS3ObjectRequest(req *http.Request) bool {
+	if !strings.Contains(req.Header.Get("Authorization"), "/s3/aws4_request") {
+		return false
+	}
+
+	path := strings.Trim(req.URL.Path, "/")
+
+	if path == "" {
+		return false
+	}
+
+	// With path-style addressing the first path segment is the bucket name.
+	if host := req.URL.Hostname(); net.ParseIP(host) != nil || !strings.Contains(host, ".") || strings.HasPrefix(host, "s3.") || strings.HasPrefix(host, "s3-") {
+		return strings.Contains(path, "/")
+	}
+
+	return true
+}
@@ -0,0 +1,251 @@
+package conns
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLoggingTransportRedactBody(t *testing.T) {
+	transport := NewLoggingTransport(&DebugLoggingConfig{
+		HTTPBodies: true,
+		Redact:     []string{"SecretAccessKey", "SessionToken"},
+	}, nil).(*loggingTransport)
+
+	testCases := []struct {
+		Name     string
+		Body     string
+		Expected string
+	}{
+		{
+			Name:     "JSON",
+			Body:     `{"AccessKeyId":"AKIA","SecretAccessKey" : "se\"cret","SessionToken":"token"}`,
+			Expected: `{"AccessKeyId":"AKIA","SecretAccessKey" : "[REDACTED]","SessionToken":"[REDACTED]"}`,
+		},
+		{
+			Name:     "XML",
+			Body:     `<Credentials><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>secret</SecretAccessKey></Credentials>`,
+			Expected: `<Credentials><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>[REDACTED]</SecretAccessKey></Credentials>`,
+		},
+		{
+			Name:     "Query",
+			Body:     `Action=Test&SecretAccessKey=secret&Version=2011-06-15`,
+			Expected: `Action=Test&SecretAccessKey=[REDACTED]&Version=2011-06-15`,
+		},
+		{
+			Name:     "no match",
+			Body:     `{"SecretAccessKeyId":"id"}`,
+			Expected: `{"SecretAccessKeyId":"id"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := transport.redactBody(testCase.Body)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestLoggingTransportRedactBody_defaultFields(t *testing.T) {
+	transport := NewLoggingTransport(&DebugLoggingConfig{
+		HTTPBodies: true,
+		Redact:     []string{"Password"},
+	}, nil).(*loggingTransport)
+
+	testCases := []struct {
+		Name     string
+		Body     string
+		Expected string
+	}{
+		{
+			Name:     "STS XML",
+			Body:     `<Credentials><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken></Credentials>`,
+			Expected: `<Credentials><AccessKeyId>AKIA</AccessKeyId><SecretAccessKey>[REDACTED]</SecretAccessKey><SessionToken>[REDACTED]</SessionToken></Credentials>`,
+		},
+		{
+			Name:     "SSO JSON",
+			Body:     `{"roleCredentials":{"accessKeyId":"AKIA","secretAccessKey":"secret","sessionToken":"token"}}`,
+			Expected: `{"roleCredentials":{"accessKeyId":"AKIA","secretAccessKey":"[REDACTED]","sessionToken":"[REDACTED]"}}`,
+		},
+		{
+			Name:     "configured",
+			Body:     `{"Password":"secret"}`,
+			Expected: `{"Password":"[REDACTED]"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := transport.redactBody(testCase.Body)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestLoggingTransportRoundTrip(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.Header().Set("X-Custom-Secret", "response-secret")
+		w.Write([]byte(`{"SecretAccessKey":"response-secret","Status":"ok"}`)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	client := &http.Client{
+		Transport: NewLoggingTransport(&DebugLoggingConfig{
+			HTTPBodies: true,
+			Redact:     []string{"SecretAccessKey", "X-Custom-Secret"},
+		}, http.DefaultTransport),
+	}
+
+	req, err := http.NewRequest(http.MethodPost, ts.URL, strings.NewReader(`{"SecretAccessKey":"request-secret"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=AKIA")
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := string(body), `{"SecretAccessKey":"response-secret","Status":"ok"}`; got != expected {
+		t.Errorf("got response body %s, expected %s", got, expected)
+	}
+
+	logged := buf.String()
+
+	for _, v := range []string{"request-secret", "response-secret", "Credential=AKIA"} {
+		if strings.Contains(logged, v) {
+			t.Errorf("logged output contains %q:\n%s", v, logged)
+		}
+	}
+
+	if !strings.Contains(logged, `"Status":"ok"`) {
+		t.Errorf("logged output does not contain response body:\n%s", logged)
+	}
+}
+
+func TestLoggingTransportSkipBody(t *testing.T) {
+	transport := NewLoggingTransport(&DebugLoggingConfig{
+		HTTPBodies: true,
+	}, nil).(*loggingTransport)
+
+	testCases := []struct {
+		Name          string
+		URL           string
+		Authorization string
+		ContentType   string
+		Logged        bool
+	}{
+		{
+			Name:          "JSON",
+			URL:           "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions",
+			Authorization: "AWS4-HMAC-SHA256 Credential=AKIA/20220101/us-west-2/lambda/aws4_request",
+			ContentType:   "application/x-amz-json-1.1",
+			Logged:        true,
+		},
+		{
+			Name:          "Query",
+			URL:           "https://iam.amazonaws.com/",
+			Authorization: "AWS4-HMAC-SHA256 Credential=AKIA/20220101/us-east-1/iam/aws4_request",
+			ContentType:   "application/x-www-form-urlencoded; charset=utf-8",
+			Logged:        true,
+		},
+		{
+			Name:          "plain text",
+			URL:           "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions",
+			Authorization: "AWS4-HMAC-SHA256 Credential=AKIA/20220101/us-west-2/lambda/aws4_request",
+			ContentType:   "text/plain",
+		},
+		{
+			Name:          "binary",
+			URL:           "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions",
+			Authorization: "AWS4-HMAC-SHA256 Credential=AKIA/20220101/us-west-2/lambda/aws4_request",
+			ContentType:   "application/octet-stream",
+		},
+		{
+			Name:          "S3 bucket virtual-hosted",
+			URL:           "https://example.s3.us-west-2.amazonaws.com/?list-type=2",
+			Authorization: "AWS4-HMAC-SHA256 Credential=AKIA/20220101/us-west-2/s3/aws4_request",
+			ContentType:   "application/xml",
+			Logged:        true,
+		},
+		{
+			Name:          "S3 bucket path-style",
+			URL:           "https://s3.us-west-2.amazonaws.com/example?tagging",
+			Authorization: "AWS4-HMAC-SHA256 Credential=AKIA/20220101/us-west-2/s3/aws4_request",
+			ContentType:   "application/xml",
+			Logged:        true,
+		},
+		{
+			Name:          "S3 object virtual-hosted",
+			URL:           "https://example.s3.us-west-2.amazonaws.com/config.json",
+			Authorization: "AWS4-HMAC-SHA256 Credential=AKIA/20220101/us-west-2/s3/aws4_request",
+			ContentType:   "application/json",
+		},
+		{
+			Name:          "S3 object path-style",
+			URL:           "https://s3.us-west-2.amazonaws.com/example/config.xml",
+			Authorization: "AWS4-HMAC-SHA256 Credential=AKIA/20220101/us-west-2/s3/aws4_request",
+			ContentType:   "application/xml",
+		},
+		{
+			Name:          "S3 object custom endpoint",
+			URL:           "http://localhost:4566/example/config.json",
+			Authorization: "AWS4-HMAC-SHA256 Credential=AKIA/20220101/us-west-2/s3/aws4_request",
+			ContentType:   "application/json",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			body := `{"Status":"ok"}`
+
+			req, err := http.NewRequest(http.MethodPut, testCase.URL, strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			req.Header.Set("Authorization", testCase.Authorization)
+			req.Header.Set("Content-Type", testCase.ContentType)
+
+			resp := &http.Response{
+				Status:  "200 OK",
+				Header:  http.Header{"Content-Type": []string{testCase.ContentType}},
+				Body:    io.NopCloser(strings.NewReader(body)),
+				Request: req,
+			}
+
+			for name, logged := range map[string]string{
+				"request":  transport.formatRequest(req),
+				"response": transport.formatResponse(resp),
+			} {
+				if got := strings.Contains(logged, body); got != testCase.Logged {
+					t.Errorf("%s body logged: got %t, expected %t:\n%s", name, got, testCase.Logged, logged)
+				}
+			}
+		})
+	}
+}
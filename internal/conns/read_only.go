@@ -0,0 +1,69 @@
+package conns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	ErrCodeReadOnlyMode = "ReadOnlyMode"
+
+	readOnlyHandlerName = "terraform-provider-aws.ReadOnlyHandler"
+)
+
+// readOperationPrefixes are the API operation name prefixes that identify calls which only read.
+// Any operation not matching one of these is treated as mutating.
+var readOperationPrefixes = []string{
+	"BatchCheck",
+	"BatchDescribe",
+	"BatchGet",
+	"Check",
+	"Count",
+	"Decrypt",
+	"Describe",
+	"Download",
+	"Encrypt",
+	"Estimate",
+	"Filter",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+	"Simulate",
+	"Validate",
+}
+
+// IsReadOperation returns whether the named API operation only reads.
+func IsReadOperation(name string) bool {
+	for _, prefix := range readOperationPrefixes {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		// Require a word boundary so that e.g. "Getaway" or "Headline" are not matched.
+		if rest := name[len(prefix):]; rest == "" || strings.ToUpper(rest[:1]) == rest[:1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReadOnlyHandler rejects all but read API operations before they are sent.
+var ReadOnlyHandler = request.NamedHandler{
+	Name: readOnlyHandlerName,
+	Fn: func(r *request.Request) {
+		if r.Operation == nil || IsReadOperation(r.Operation.Name) {
+			return
+		}
+
+		r.Error = awserr.New(ErrCodeReadOnlyMode, fmt.Sprintf("%s:%s was not called because the provider is configured with read_only = true", r.ClientInfo.ServiceName, r.Operation.Name), nil)
+	},
+}
@@ -0,0 +1,165 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+)
+
+func TestIsReadOperation(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Expected bool
+	}{
+		{Name: "DescribeInstances", Expected: true},
+		{Name: "GetCallerIdentity", Expected: true},
+		{Name: "ListTagsForResource", Expected: true},
+		{Name: "HeadObject", Expected: true},
+		{Name: "SearchResources", Expected: true},
+		{Name: "LookupEvents", Expected: true},
+		{Name: "BatchGetItem", Expected: true},
+		{Name: "Query", Expected: true},
+		{Name: "Decrypt", Expected: true},
+		{Name: "CreateBucket", Expected: false},
+		{Name: "DeleteRole", Expected: false},
+		{Name: "PutBucketPolicy", Expected: false},
+		{Name: "UpdateFunctionCode", Expected: false},
+		{Name: "TagResource", Expected: false},
+		{Name: "RunInstances", Expected: false},
+		{Name: "ChangeResourceRecordSets", Expected: false},
+		{Name: "ScheduleKeyDeletion", Expected: false},
+		{Name: "RequestCertificate", Expected: false},
+		{Name: "Subscribe", Expected: false},
+		{Name: "Unsubscribe", Expected: false},
+		{Name: "PurchaseReservedInstancesOffering", Expected: false},
+		{Name: "BatchWriteItem", Expected: false},
+		{Name: "AdminCreateUser", Expected: false},
+		{Name: "IncreaseReplicationFactor", Expected: false},
+		{Name: "MergeShards", Expected: false},
+		{Name: "RetireGrant", Expected: false},
+		{Name: "ProvisionProduct", Expected: false},
+		{Name: "ConfirmSubscription", Expected: false},
+		{Name: "Getaway", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := IsReadOperation(testCase.Name); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestReadOnlyHandler(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		OperationName string
+		ExpectError   bool
+	}{
+		{
+			Name:          "read",
+			OperationName: "DescribeInstances",
+		},
+		{
+			Name:          "mutating",
+			OperationName: "TerminateInstances",
+			ExpectError:   true,
+		},
+		{
+			Name:          "unlisted verb",
+			OperationName: "ChangeResourceRecordSets",
+			ExpectError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := request.New(aws.Config{}, metadata.ClientInfo{ServiceName: "ec2"}, request.Handlers{}, nil, &request.Operation{Name: testCase.OperationName}, nil, nil)
+
+			ReadOnlyHandler.Fn(r)
+
+			if !testCase.ExpectError {
+				if r.Error != nil {
+					t.Fatalf("unexpected error: %s", r.Error)
+				}
+
+				return
+			}
+
+			if err, ok := r.Error.(awserr.Error); !ok || err.Code() != ErrCodeReadOnlyMode {
+				t.Fatalf("expected %s error, got: %v", ErrCodeReadOnlyMode, r.Error)
+			}
+		})
+	}
+}
+
+func TestAWSClientNewSessionForRegion_readOnly(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		ReadOnly    bool
+		ExpectError bool
+	}{
+		{
+			Name: "read_only disabled",
+		},
+		{
+			Name:        "read_only enabled",
+			ReadOnly:    true,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := &AWSClient{
+				ReadOnly:         testCase.ReadOnly,
+				TerraformVersion: "1.0.0",
+			}
+
+			cfg := &aws.Config{
+				Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+				Region:      aws.String("us-east-1"),
+			}
+
+			sess, err := client.NewSessionForRegion(cfg, "us-west-2")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := aws.StringValue(sess.Config.Region), "us-west-2"; got != expected {
+				t.Errorf("got region %s, expected %s", got, expected)
+			}
+
+			conn := kms.New(sess)
+
+			// Build runs the Validate handlers without sending the request.
+			readReq, _ := conn.DescribeKeyRequest(&kms.DescribeKeyInput{KeyId: aws.String("alias/test")})
+
+			if err := readReq.Build(); err != nil {
+				t.Fatalf("unexpected error building read request: %s", err)
+			}
+
+			writeReq, _ := conn.ReplicateKeyRequest(&kms.ReplicateKeyInput{KeyId: aws.String("alias/test"), ReplicaRegion: aws.String("us-east-1")})
+			err = writeReq.Build()
+
+			if !testCase.ExpectError {
+				if err != nil {
+					t.Fatalf("unexpected error building write request: %s", err)
+				}
+
+				return
+			}
+
+			if err, ok := err.(awserr.Error); !ok || err.Code() != ErrCodeReadOnlyMode {
+				t.Fatalf("expected %s error, got: %v", ErrCodeReadOnlyMode, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,408 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	filename = "find_gen.go"
+)
+
+var (
+	awsService      = flag.String("AWSService", "", "name of the AWS Go SDK service package, defaults to the name of the working directory")
+	notFoundErrCode = flag.String("NotFoundErrCode", "", "name of the AWS Go SDK error code constant returned when the resource is not found, e.g. ErrCodeNotFoundException")
+	finders         finderFlags
+)
+
+func init() {
+	flag.Var(&finders, "Finder", "finder specification, may be repeated (see README.md)")
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// finderFlags collects repeated -Finder flags.
+type finderFlags []map[string]string
+
+func (f *finderFlags) String() string {
+	return ""
+}
+
+func (f *finderFlags) Set(value string) error {
+	spec := make(map[string]string)
+
+	for _, kv := range strings.Split(value, ",") {
+		parts := strings.SplitN(kv, "=", 2)
+
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("invalid finder specification %q: expected Key=Value pairs", value)
+		}
+
+		spec[parts[0]] = parts[1]
+	}
+
+	for _, key := range []string{"Name", "By", "Op", "InputField"} {
+		if spec[key] == "" {
+			return fmt.Errorf("invalid finder specification %q: %s is required", value, key)
+		}
+	}
+
+	*f = append(*f, spec)
+
+	return nil
+}
+
+type HeaderInfo struct {
+	Parameters         string
+	DestinationPackage string
+	SourcePackage      string
+	Context            bool
+}
+
+type FuncSpec struct {
+	Name            string
+	Param           string
+	Context         bool
+	RecvType        string
+	AWSName         string
+	InputType       string
+	InputField      string
+	InputList       bool
+	OutputField     string
+	OutputList      bool
+	ResultType      string
+	MatchField      string
+	NotFoundErrCode string
+	SourcePackage   string
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if len(finders) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+	sdkPackage := *awsService
+
+	if sdkPackage == "" {
+		sdkPackage = servicePackage
+	}
+
+	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go/service/%s", sdkPackage)
+
+	g := Generator{
+		tmpl: template.Must(template.New("function").Funcs(template.FuncMap{"paramName": paramName}).Parse(functionTemplate)),
+	}
+
+	g.parsePackage(sourcePackage)
+
+	sort.Slice(finders, func(i, j int) bool {
+		return finders[i]["Name"]+finders[i]["By"] < finders[j]["Name"]+finders[j]["By"]
+	})
+
+	var funcSpecs []FuncSpec
+	context := false
+
+	for _, spec := range finders {
+		funcSpec := g.funcSpec(spec)
+		context = context || funcSpec.Context
+		funcSpecs = append(funcSpecs, funcSpec)
+	}
+
+	g.printHeader(HeaderInfo{
+		Parameters:         strings.Join(os.Args[1:], " "),
+		DestinationPackage: servicePackage,
+		SourcePackage:      sourcePackage,
+		Context:            context,
+	})
+
+	for _, funcSpec := range funcSpecs {
+		if err := g.tmpl.Execute(&g.buf, funcSpec); err != nil {
+			log.Fatalf("error writing finder \"Find%sBy%s\": %s", funcSpec.Name, funcSpec.Param, err)
+		}
+	}
+
+	src := g.format()
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+type Generator struct {
+	buf  bytes.Buffer
+	pkg  *packages.Package
+	tmpl *template.Template
+}
+
+func (g *Generator) printHeader(headerInfo HeaderInfo) {
+	header := template.Must(template.New("header").Parse(headerTemplate))
+
+	if err := header.Execute(&g.buf, headerInfo); err != nil {
+		log.Fatalf("error writing header: %s", err)
+	}
+}
+
+func (g *Generator) parsePackage(sourcePackage string) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedSyntax,
+	}
+	pkgs, err := packages.Load(cfg, sourcePackage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+	g.pkg = pkgs[0]
+}
+
+func (g *Generator) funcSpec(spec map[string]string) FuncSpec {
+	function := g.findFunc(spec["Op"])
+
+	if function == nil {
+		log.Fatalf("function \"%s\" not found", spec["Op"])
+	}
+
+	inputType := fmt.Sprintf("%sInput", spec["Op"])
+	outputType := fmt.Sprintf("%sOutput", spec["Op"])
+
+	funcSpec := FuncSpec{
+		Name:            spec["Name"],
+		Param:           spec["By"],
+		RecvType:        g.expandTypeExpr(function.Recv.List[0].Type),
+		AWSName:         spec["Op"],
+		InputType:       fmt.Sprintf("%s.%s", g.pkg.Name, inputType),
+		InputField:      spec["InputField"],
+		OutputField:     spec["OutputField"],
+		ResultType:      fmt.Sprintf("*%s.%s", g.pkg.Name, outputType),
+		MatchField:      spec["MatchField"],
+		NotFoundErrCode: *notFoundErrCode,
+		SourcePackage:   g.pkg.Name,
+	}
+
+	if v, ok := spec["NotFoundErrCode"]; ok {
+		funcSpec.NotFoundErrCode = v
+	}
+
+	if v, ok := spec["Context"]; ok {
+		context, err := strconv.ParseBool(v)
+
+		if err != nil {
+			log.Fatalf("invalid Context value %q: %s", v, err)
+		}
+
+		funcSpec.Context = context
+	}
+
+	switch fieldType := g.findField(inputType, funcSpec.InputField).(type) {
+	case *ast.StarExpr:
+	case *ast.ArrayType:
+		funcSpec.InputList = true
+	default:
+		log.Fatalf("unsupported type for %s.%s: %T", inputType, funcSpec.InputField, fieldType)
+	}
+
+	if funcSpec.OutputField != "" {
+		switch fieldType := g.findField(outputType, funcSpec.OutputField).(type) {
+		case *ast.StarExpr:
+			funcSpec.ResultType = g.expandTypeExpr(fieldType)
+		case *ast.ArrayType:
+			funcSpec.OutputList = true
+			funcSpec.ResultType = g.expandTypeExpr(fieldType.Elt)
+		default:
+			log.Fatalf("unsupported type for %s.%s: %T", outputType, funcSpec.OutputField, fieldType)
+		}
+	}
+
+	if funcSpec.MatchField != "" && !funcSpec.OutputList {
+		log.Fatalf("MatchField is only supported for list output fields")
+	}
+
+	return funcSpec
+}
+
+func (g *Generator) findFunc(name string) *ast.FuncDecl {
+	for _, file := range g.pkg.Syntax {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv != nil && funcDecl.Name.Name == name {
+				return funcDecl
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) findField(typeName, fieldName string) ast.Expr {
+	for _, file := range g.pkg.Syntax {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+
+			if !ok {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+
+				if !ok || typeSpec.Name.Name != typeName {
+					continue
+				}
+
+				structType, ok := typeSpec.Type.(*ast.StructType)
+
+				if !ok {
+					log.Fatalf("type \"%s\" is not a struct", typeName)
+				}
+
+				for _, field := range structType.Fields.List {
+					for _, name := range field.Names {
+						if name.Name == fieldName {
+							return field.Type
+						}
+					}
+				}
+
+				log.Fatalf("field \"%s.%s\" not found", typeName, fieldName)
+			}
+		}
+	}
+
+	log.Fatalf("type \"%s\" not found", typeName)
+
+	return nil
+}
+
+func (g *Generator) expandTypeExpr(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.StarExpr:
+		return fmt.Sprintf("*%s", g.expandTypeExpr(v.X))
+	case *ast.Ident:
+		return fmt.Sprintf("%s.%s", g.pkg.Name, v.Name)
+	}
+
+	log.Fatalf("Unexpected expression: (%[1]T) %[1]v", expr)
+	return ""
+}
+
+func (g *Generator) format() []byte {
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		return g.buf.Bytes()
+	}
+	return src
+}
+
+// paramName returns the Go parameter name for a finder's By value, e.g. "ID" -> "id", "AccountID" -> "accountID".
+func paramName(s string) string {
+	if strings.ToUpper(s) == s {
+		return strings.ToLower(s)
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+const headerTemplate = `// Code generated by "internal/generate/finders/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+{{- if .Context }}
+	"context"
+{{ end }}
+	"github.com/aws/aws-sdk-go/aws"
+	"{{ .SourcePackage }}"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+`
+
+const functionTemplate = `
+{{ $param := .Param | paramName }}
+func Find{{ .Name }}By{{ .Param }}({{ if .Context }}ctx context.Context, {{ end }}conn {{ .RecvType }}, {{ $param }} string) ({{ .ResultType }}, error) {
+	input := &{{ .InputType }}{
+	{{- if .InputList }}
+		{{ .InputField }}: aws.StringSlice([]string{ {{- $param -}} }),
+	{{- else }}
+		{{ .InputField }}: aws.String({{ $param }}),
+	{{- end }}
+	}
+
+	output, err := conn.{{ .AWSName }}{{ if .Context }}WithContext(ctx, input){{ else }}(input){{ end }}
+
+	if tfawserr.ErrCodeEquals(err, {{ .SourcePackage }}.{{ .NotFoundErrCode }}) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+{{ if .OutputList }}
+	if output == nil || len(output.{{ .OutputField }}) == 0 || output.{{ .OutputField }}[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.{{ .OutputField }}); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+{{ if .MatchField }}
+	if v := output.{{ .OutputField }}[0]; aws.StringValue(v.{{ .MatchField }}) != {{ $param }} {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+{{ end }}
+	return output.{{ .OutputField }}[0], nil
+{{- else if .OutputField }}
+	if output == nil || output.{{ .OutputField }} == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.{{ .OutputField }}, nil
+{{- else }}
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+{{- end }}
+}
+`
@@ -802,6 +802,8 @@ func awsServiceName(s string) (string, error) {
 		return "lexmodelbuildingservice", nil
 	case "serverlessrepo":
 		return "serverlessapplicationrepository", nil
+	case "wisdom":
+		return "connectwisdomservice", nil
 	}
 
 	if _, ok := awsServiceNames[fmt.Sprintf("%sservice", s)]; ok {
@@ -837,6 +839,8 @@ func awsServiceNameUpper(s string) (string, error) {
 		return awsServiceNames["lexmodelbuildingservice"], nil
 	case "serverlessrepo":
 		return awsServiceNames["serverlessapplicationrepository"], nil
+	case "wisdom":
+		return awsServiceNames["connectwisdomservice"], nil
 	}
 
 	if v, ok := awsServiceNames[fmt.Sprintf("%sservice", s)]; ok {
@@ -870,7 +874,7 @@ func init() {
 	awsServiceNames["apigatewayv2"] = "ApiGatewayV2"
 	awsServiceNames["appconfig"] = "AppConfig"
 	awsServiceNames["appflow"] = "AppFlow"
-	awsServiceNames["appintegrations"] = "AppIntegrations"
+	awsServiceNames["appintegrationsservice"] = "AppIntegrationsService"
 	awsServiceNames["applicationautoscaling"] = "ApplicationAutoScaling"
 	awsServiceNames["applicationcostprofiler"] = "ApplicationCostProfiler"
 	awsServiceNames["applicationdiscovery"] = "ApplicationDiscovery"
@@ -922,6 +926,7 @@ func init() {
 	awsServiceNames["connect"] = "Connect"
 	awsServiceNames["connectcontactlens"] = "ConnectContactLens"
 	awsServiceNames["connectparticipant"] = "ConnectParticipant"
+	awsServiceNames["connectwisdomservice"] = "ConnectWisdomService"
 	awsServiceNames["costexplorer"] = "CostExplorer"
 	awsServiceNames["cur"] = "CUR"
 	awsServiceNames["customerprofiles"] = "CustomerProfiles"
@@ -1138,5 +1143,6 @@ func init() {
 	awsServiceNames["workmail"] = "WorkMail"
 	awsServiceNames["workmailmessageflow"] = "WorkMailMessageFlow"
 	awsServiceNames["workspaces"] = "WorkSpaces"
+	awsServiceNames["workspacesweb"] = "WorkSpacesWeb"
 	awsServiceNames["xray"] = "XRay"
 }
@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"regexp"
+	"strings"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -21,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/appintegrations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
@@ -160,11 +163,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/wisdom"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
 )
 
 // Provider returns a *schema.Provider.
@@ -175,6 +181,14 @@ func Provider() *schema.Provider {
 	// The actual provider
 	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
+			"abandon_delete_after_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "The number of attempts after which resources supporting it stop retrying a\n" +
+					"stuck deletion and remove the resource from state. 0 disables the behavior.",
+			},
 			"access_key": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -190,6 +204,29 @@ func Provider() *schema.Provider {
 				Set:           schema.HashString,
 			},
 			"assume_role": assumeRoleSchema(),
+			"debug_logging": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for logging API requests and responses.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_bodies": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Log JSON, XML and form-encoded request and response bodies, excluding S3 object contents.",
+						},
+						"redact": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Header and body field names whose values are redacted from logs.",
+						},
+					},
+				},
+			},
 			"default_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -278,6 +315,13 @@ func Provider() *schema.Provider {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Refuse to make mutating API calls (e.g. Create*, Update*, Delete*, Put*). " +
+					"Used to run plans with credentials that may be over-privileged.",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -390,6 +434,32 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"user_agent": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Product details to append to the User-Agent header of every API request.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comment": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Comment describing any additional product details.",
+						},
+						"product_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Product name. For example, a team, workspace or module name.",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[!#$%&'*+\-.^_`+"`"+`|~0-9A-Za-z]+$`), "must be a valid HTTP token"),
+						},
+						"product_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Product version.",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[!#$%&'*+\-.^_`+"`"+`|~0-9A-Za-z]*$`), "must be a valid HTTP token"),
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -413,6 +483,8 @@ func Provider() *schema.Provider {
 			"aws_appmesh_mesh":            appmesh.DataSourceMesh(),
 			"aws_appmesh_virtual_service": appmesh.DataSourceVirtualService(),
 
+			"aws_apprunner_service_operations": apprunner.DataSourceServiceOperations(),
+
 			"aws_autoscaling_group":    autoscaling.DataSourceGroup(),
 			"aws_autoscaling_groups":   autoscaling.DataSourceGroups(),
 			"aws_launch_configuration": autoscaling.DataSourceLaunchConfiguration(),
@@ -477,6 +549,8 @@ func Provider() *schema.Provider {
 			"aws_datapipeline_pipeline":            datapipeline.DataSourcePipeline(),
 			"aws_datapipeline_pipeline_definition": datapipeline.DataSourcePipelineDefinition(),
 
+			"aws_dax_parameter_group": dax.DataSourceParameterGroup(),
+
 			"aws_docdb_engine_version":        docdb.DataSourceEngineVersion(),
 			"aws_docdb_orderable_db_instance": docdb.DataSourceOrderableDBInstance(),
 
@@ -516,6 +590,7 @@ func Provider() *schema.Provider {
 			"aws_ec2_local_gateway":                          ec2.DataSourceLocalGateway(),
 			"aws_ec2_local_gateways":                         ec2.DataSourceLocalGateways(),
 			"aws_ec2_managed_prefix_list":                    ec2.DataSourceManagedPrefixList(),
+			"aws_ec2_spot_placement_scores":                  ec2.DataSourceSpotPlacementScores(),
 			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
 			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
 			"aws_ec2_transit_gateway_dx_gateway_attachment":  ec2.DataSourceTransitGatewayDxGatewayAttachment(),
@@ -602,6 +677,11 @@ func Provider() *schema.Provider {
 
 			"aws_kinesis_firehose_delivery_stream": firehose.DataSourceDeliveryStream(),
 
+			"aws_gamelift_alias":           gamelift.DataSourceAlias(),
+			"aws_gamelift_build":           gamelift.DataSourceBuild(),
+			"aws_gamelift_fleet":           gamelift.DataSourceFleet(),
+			"aws_gamelift_fleet_instances": gamelift.DataSourceFleetInstances(),
+
 			"aws_globalaccelerator_accelerator": globalaccelerator.DataSourceAccelerator(),
 
 			"aws_glue_connection":                       glue.DataSourceConnection(),
@@ -744,7 +824,8 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_object":  s3.DataSourceBucketObject(),  // DEPRECATED: use aws_s3_object instead
 			"aws_s3_bucket_objects": s3.DataSourceBucketObjects(), // DEPRECATED: use aws_s3_objects instead
 
-			"aws_sagemaker_prebuilt_ecr_image": sagemaker.DataSourcePrebuiltECRImage(),
+			"aws_sagemaker_inference_recommendations_job": sagemaker.DataSourceInferenceRecommendationsJob(),
+			"aws_sagemaker_prebuilt_ecr_image":            sagemaker.DataSourcePrebuiltECRImage(),
 
 			"aws_secretsmanager_secret":          secretsmanager.DataSourceSecret(),
 			"aws_secretsmanager_secret_rotation": secretsmanager.DataSourceSecretRotation(),
@@ -875,6 +956,9 @@ func Provider() *schema.Provider {
 			"aws_appconfig_environment":                  appconfig.ResourceEnvironment(),
 			"aws_appconfig_hosted_configuration_version": appconfig.ResourceHostedConfigurationVersion(),
 
+			"aws_appintegrations_data_integration":  appintegrations.ResourceDataIntegration(),
+			"aws_appintegrations_event_integration": appintegrations.ResourceEventIntegration(),
+
 			"aws_appautoscaling_policy":           appautoscaling.ResourcePolicy(),
 			"aws_appautoscaling_scheduled_action": appautoscaling.ResourceScheduledAction(),
 			"aws_appautoscaling_target":           appautoscaling.ResourceTarget(),
@@ -973,8 +1057,9 @@ func Provider() *schema.Provider {
 			"aws_cloudfront_realtime_log_config":            cloudfront.ResourceRealtimeLogConfig(),
 			"aws_cloudfront_response_headers_policy":        cloudfront.ResourceResponseHeadersPolicy(),
 
-			"aws_cloudhsm_v2_cluster": cloudhsmv2.ResourceCluster(),
-			"aws_cloudhsm_v2_hsm":     cloudhsmv2.ResourceHSM(),
+			"aws_cloudhsm_v2_cluster":                cloudhsmv2.ResourceCluster(),
+			"aws_cloudhsm_v2_cluster_initialization": cloudhsmv2.ResourceClusterInitialization(),
+			"aws_cloudhsm_v2_hsm":                    cloudhsmv2.ResourceHSM(),
 
 			"aws_cloudsearch_domain":                       cloudsearch.ResourceDomain(),
 			"aws_cloudsearch_domain_service_access_policy": cloudsearch.ResourceDomainServiceAccessPolicy(),
@@ -1164,6 +1249,7 @@ func Provider() *schema.Provider {
 			"aws_ebs_volume":                                      ec2.ResourceEBSVolume(),
 			"aws_ec2_availability_zone_group":                     ec2.ResourceAvailabilityZoneGroup(),
 			"aws_ec2_capacity_reservation":                        ec2.ResourceCapacityReservation(),
+			"aws_ec2_capacity_reservation_fleet":                  ec2.ResourceCapacityReservationFleet(),
 			"aws_ec2_carrier_gateway":                             ec2.ResourceCarrierGateway(),
 			"aws_ec2_client_vpn_authorization_rule":               ec2.ResourceClientVPNAuthorizationRule(),
 			"aws_ec2_client_vpn_endpoint":                         ec2.ResourceClientVPNEndpoint(),
@@ -1171,10 +1257,14 @@ func Provider() *schema.Provider {
 			"aws_ec2_client_vpn_route":                            ec2.ResourceClientVPNRoute(),
 			"aws_ec2_fleet":                                       ec2.ResourceFleet(),
 			"aws_ec2_host":                                        ec2.ResourceHost(),
+			"aws_ec2_instance_state":                              ec2.ResourceInstanceState(),
 			"aws_ec2_local_gateway_route":                         ec2.ResourceLocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":   ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                         ec2.ResourceManagedPrefixList(),
 			"aws_ec2_managed_prefix_list_entry":                   ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_network_insights_analysis":                   ec2.ResourceNetworkInsightsAnalysis(),
+			"aws_ec2_network_insights_path":                       ec2.ResourceNetworkInsightsPath(),
+			"aws_ec2_serial_console_access":                       ec2.ResourceSerialConsoleAccess(),
 			"aws_ec2_subnet_cidr_reservation":                     ec2.ResourceSubnetCIDRReservation(),
 			"aws_ec2_tag":                                         ec2.ResourceTag(),
 			"aws_ec2_traffic_mirror_filter":                       ec2.ResourceTrafficMirrorFilter(),
@@ -1182,6 +1272,8 @@ func Provider() *schema.Provider {
 			"aws_ec2_traffic_mirror_session":                      ec2.ResourceTrafficMirrorSession(),
 			"aws_ec2_traffic_mirror_target":                       ec2.ResourceTrafficMirrorTarget(),
 			"aws_ec2_transit_gateway":                             ec2.ResourceTransitGateway(),
+			"aws_ec2_transit_gateway_connect":                     ec2.ResourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":                ec2.ResourceTransitGatewayConnectPeer(),
 			"aws_ec2_transit_gateway_peering_attachment":          ec2.ResourceTransitGatewayPeeringAttachment(),
 			"aws_ec2_transit_gateway_peering_attachment_accepter": ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
 			"aws_ec2_transit_gateway_prefix_list_reference":       ec2.ResourceTransitGatewayPrefixListReference(),
@@ -1228,6 +1320,7 @@ func Provider() *schema.Provider {
 			"aws_vpc_endpoint_route_table_association":            ec2.ResourceVPCEndpointRouteTableAssociation(),
 			"aws_vpc_endpoint_service":                            ec2.ResourceVPCEndpointService(),
 			"aws_vpc_endpoint_service_allowed_principal":          ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
+			"aws_vpc_endpoint_service_private_dns_verification":   ec2.ResourceVPCEndpointServicePrivateDNSVerification(),
 			"aws_vpc_endpoint_subnet_association":                 ec2.ResourceVPCEndpointSubnetAssociation(),
 			"aws_vpc_ipam":                                        ec2.ResourceVPCIpam(),
 			"aws_vpc_ipam_organization_admin_account":             ec2.ResourceVPCIpamOrganizationAdminAccount(),
@@ -1241,6 +1334,8 @@ func Provider() *schema.Provider {
 			"aws_vpc_peering_connection":                          ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                 ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                  ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_security_group_egress_rule":                  ec2.ResourceSecurityGroupEgressRule(),
+			"aws_vpc_security_group_ingress_rule":                 ec2.ResourceSecurityGroupIngressRule(),
 			"aws_vpn_connection":                                  ec2.ResourceVPNConnection(),
 			"aws_vpn_connection_route":                            ec2.ResourceVPNConnectionRoute(),
 			"aws_vpn_gateway":                                     ec2.ResourceVPNGateway(),
@@ -1323,13 +1418,14 @@ func Provider() *schema.Provider {
 			"aws_lb_target_group":             elbv2.ResourceTargetGroup(),
 			"aws_lb_target_group_attachment":  elbv2.ResourceTargetGroupAttachment(),
 
-			"aws_emr_cluster":                emr.ResourceCluster(),
-			"aws_emr_instance_fleet":         emr.ResourceInstanceFleet(),
-			"aws_emr_instance_group":         emr.ResourceInstanceGroup(),
-			"aws_emr_managed_scaling_policy": emr.ResourceManagedScalingPolicy(),
-			"aws_emr_security_configuration": emr.ResourceSecurityConfiguration(),
-			"aws_emr_studio":                 emr.ResourceStudio(),
-			"aws_emr_studio_session_mapping": emr.ResourceStudioSessionMapping(),
+			"aws_emr_cluster":                 emr.ResourceCluster(),
+			"aws_emr_instance_fleet":          emr.ResourceInstanceFleet(),
+			"aws_emr_instance_group":          emr.ResourceInstanceGroup(),
+			"aws_emr_managed_scaling_policy":  emr.ResourceManagedScalingPolicy(),
+			"aws_emr_security_configuration":  emr.ResourceSecurityConfiguration(),
+			"aws_emr_studio":                  emr.ResourceStudio(),
+			"aws_emr_studio_session_mapping":  emr.ResourceStudioSessionMapping(),
+			"aws_emr_studio_session_mappings": emr.ResourceStudioSessionMappings(),
 
 			"aws_kinesis_firehose_delivery_stream": firehose.ResourceDeliveryStream(),
 
@@ -1350,6 +1446,7 @@ func Provider() *schema.Provider {
 			"aws_gamelift_alias":              gamelift.ResourceAlias(),
 			"aws_gamelift_build":              gamelift.ResourceBuild(),
 			"aws_gamelift_fleet":              gamelift.ResourceFleet(),
+			"aws_gamelift_fleet_autoscaling":  gamelift.ResourceFleetAutoscaling(),
 			"aws_gamelift_game_session_queue": gamelift.ResourceGameSessionQueue(),
 
 			"aws_glacier_vault":      glacier.ResourceVault(),
@@ -1388,31 +1485,33 @@ func Provider() *schema.Provider {
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
 			"aws_guardduty_threatintelset":             guardduty.ResourceThreatintelset(),
 
-			"aws_iam_access_key":                  iam.ResourceAccessKey(),
-			"aws_iam_account_alias":               iam.ResourceAccountAlias(),
-			"aws_iam_account_password_policy":     iam.ResourceAccountPasswordPolicy(),
-			"aws_iam_group":                       iam.ResourceGroup(),
-			"aws_iam_group_membership":            iam.ResourceGroupMembership(),
-			"aws_iam_group_policy":                iam.ResourceGroupPolicy(),
-			"aws_iam_group_policy_attachment":     iam.ResourceGroupPolicyAttachment(),
-			"aws_iam_instance_profile":            iam.ResourceInstanceProfile(),
-			"aws_iam_openid_connect_provider":     iam.ResourceOpenIDConnectProvider(),
-			"aws_iam_policy":                      iam.ResourcePolicy(),
-			"aws_iam_policy_attachment":           iam.ResourcePolicyAttachment(),
-			"aws_iam_role":                        iam.ResourceRole(),
-			"aws_iam_role_policy":                 iam.ResourceRolePolicy(),
-			"aws_iam_role_policy_attachment":      iam.ResourceRolePolicyAttachment(),
-			"aws_iam_saml_provider":               iam.ResourceSamlProvider(),
-			"aws_iam_server_certificate":          iam.ResourceServerCertificate(),
-			"aws_iam_service_linked_role":         iam.ResourceServiceLinkedRole(),
-			"aws_iam_service_specific_credential": iam.ResourceServiceSpecificCredential(),
-			"aws_iam_user":                        iam.ResourceUser(),
-			"aws_iam_user_group_membership":       iam.ResourceUserGroupMembership(),
-			"aws_iam_user_login_profile":          iam.ResourceUserLoginProfile(),
-			"aws_iam_user_policy":                 iam.ResourceUserPolicy(),
-			"aws_iam_user_policy_attachment":      iam.ResourceUserPolicyAttachment(),
-			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
-			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMfaDevice(),
+			"aws_iam_access_key":                        iam.ResourceAccessKey(),
+			"aws_iam_account_alias":                     iam.ResourceAccountAlias(),
+			"aws_iam_account_password_policy":           iam.ResourceAccountPasswordPolicy(),
+			"aws_iam_group":                             iam.ResourceGroup(),
+			"aws_iam_group_membership":                  iam.ResourceGroupMembership(),
+			"aws_iam_group_policy":                      iam.ResourceGroupPolicy(),
+			"aws_iam_group_policy_attachment":           iam.ResourceGroupPolicyAttachment(),
+			"aws_iam_instance_profile":                  iam.ResourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":           iam.ResourceOpenIDConnectProvider(),
+			"aws_iam_policy":                            iam.ResourcePolicy(),
+			"aws_iam_policy_attachment":                 iam.ResourcePolicyAttachment(),
+			"aws_iam_role":                              iam.ResourceRole(),
+			"aws_iam_role_policy":                       iam.ResourceRolePolicy(),
+			"aws_iam_role_policy_attachment":            iam.ResourceRolePolicyAttachment(),
+			"aws_iam_role_policy_attachments_exclusive": iam.ResourceRolePolicyAttachmentsExclusive(),
+			"aws_iam_saml_provider":                     iam.ResourceSamlProvider(),
+			"aws_iam_server_certificate":                iam.ResourceServerCertificate(),
+			"aws_iam_service_linked_role":               iam.ResourceServiceLinkedRole(),
+			"aws_iam_service_specific_credential":       iam.ResourceServiceSpecificCredential(),
+			"aws_iam_signing_certificate":               iam.ResourceSigningCertificate(),
+			"aws_iam_user":                              iam.ResourceUser(),
+			"aws_iam_user_group_membership":             iam.ResourceUserGroupMembership(),
+			"aws_iam_user_login_profile":                iam.ResourceUserLoginProfile(),
+			"aws_iam_user_policy":                       iam.ResourceUserPolicy(),
+			"aws_iam_user_policy_attachment":            iam.ResourceUserPolicyAttachment(),
+			"aws_iam_user_ssh_key":                      iam.ResourceUserSSHKey(),
+			"aws_iam_virtual_mfa_device":                iam.ResourceVirtualMfaDevice(),
 
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_container_recipe":             imagebuilder.ResourceContainerRecipe(),
@@ -1466,16 +1565,17 @@ func Provider() *schema.Provider {
 			"aws_lakeformation_permissions":        lakeformation.ResourcePermissions(),
 			"aws_lakeformation_resource":           lakeformation.ResourceResource(),
 
-			"aws_lambda_alias":                          lambda.ResourceAlias(),
-			"aws_lambda_code_signing_config":            lambda.ResourceCodeSigningConfig(),
-			"aws_lambda_event_source_mapping":           lambda.ResourceEventSourceMapping(),
-			"aws_lambda_function":                       lambda.ResourceFunction(),
-			"aws_lambda_function_event_invoke_config":   lambda.ResourceFunctionEventInvokeConfig(),
-			"aws_lambda_invocation":                     lambda.ResourceInvocation(),
-			"aws_lambda_layer_version":                  lambda.ResourceLayerVersion(),
-			"aws_lambda_layer_version_permission":       lambda.ResourceLayerVersionPermission(),
-			"aws_lambda_permission":                     lambda.ResourcePermission(),
-			"aws_lambda_provisioned_concurrency_config": lambda.ResourceProvisionedConcurrencyConfig(),
+			"aws_lambda_alias":                               lambda.ResourceAlias(),
+			"aws_lambda_code_signing_config":                 lambda.ResourceCodeSigningConfig(),
+			"aws_lambda_event_source_mapping":                lambda.ResourceEventSourceMapping(),
+			"aws_lambda_function":                            lambda.ResourceFunction(),
+			"aws_lambda_function_event_invoke_config":        lambda.ResourceFunctionEventInvokeConfig(),
+			"aws_lambda_invocation":                          lambda.ResourceInvocation(),
+			"aws_lambda_layer_version":                       lambda.ResourceLayerVersion(),
+			"aws_lambda_layer_version_permission":            lambda.ResourceLayerVersionPermission(),
+			"aws_lambda_permission":                          lambda.ResourcePermission(),
+			"aws_lambda_provisioned_concurrency_autoscaling": lambda.ResourceProvisionedConcurrencyAutoscaling(),
+			"aws_lambda_provisioned_concurrency_config":      lambda.ResourceProvisionedConcurrencyConfig(),
 
 			"aws_lex_bot":       lexmodels.ResourceBot(),
 			"aws_lex_bot_alias": lexmodels.ResourceBotAlias(),
@@ -1575,10 +1675,13 @@ func Provider() *schema.Provider {
 
 			"aws_qldb_ledger": qldb.ResourceLedger(),
 
-			"aws_quicksight_data_source":      quicksight.ResourceDataSource(),
-			"aws_quicksight_group":            quicksight.ResourceGroup(),
-			"aws_quicksight_group_membership": quicksight.ResourceGroupMembership(),
-			"aws_quicksight_user":             quicksight.ResourceUser(),
+			"aws_quicksight_data_source":       quicksight.ResourceDataSource(),
+			"aws_quicksight_folder":            quicksight.ResourceFolder(),
+			"aws_quicksight_folder_membership": quicksight.ResourceFolderMembership(),
+			"aws_quicksight_group":             quicksight.ResourceGroup(),
+			"aws_quicksight_group_membership":  quicksight.ResourceGroupMembership(),
+			"aws_quicksight_namespace":         quicksight.ResourceNamespace(),
+			"aws_quicksight_user":              quicksight.ResourceUser(),
 
 			"aws_ram_principal_association":   ram.ResourcePrincipalAssociation(),
 			"aws_ram_resource_association":    ram.ResourceResourceAssociation(),
@@ -1865,6 +1968,10 @@ func Provider() *schema.Provider {
 			"aws_wafv2_web_acl_association":           wafv2.ResourceWebACLAssociation(),
 			"aws_wafv2_web_acl_logging_configuration": wafv2.ResourceWebACLLoggingConfiguration(),
 
+			"aws_wisdom_assistant":             wisdom.ResourceAssistant(),
+			"aws_wisdom_assistant_association": wisdom.ResourceAssistantAssociation(),
+			"aws_wisdom_knowledge_base":        wisdom.ResourceKnowledgeBase(),
+
 			"aws_worklink_fleet": worklink.ResourceFleet(),
 			"aws_worklink_website_certificate_authority_association": worklink.ResourceWebsiteCertificateAuthorityAssociation(),
 
@@ -1872,6 +1979,14 @@ func Provider() *schema.Provider {
 			"aws_workspaces_ip_group":  workspaces.ResourceIPGroup(),
 			"aws_workspaces_workspace": workspaces.ResourceWorkspace(),
 
+			"aws_workspacesweb_browser_settings":             workspacesweb.ResourceBrowserSettings(),
+			"aws_workspacesweb_browser_settings_association": workspacesweb.ResourceBrowserSettingsAssociation(),
+			"aws_workspacesweb_network_settings":             workspacesweb.ResourceNetworkSettings(),
+			"aws_workspacesweb_network_settings_association": workspacesweb.ResourceNetworkSettingsAssociation(),
+			"aws_workspacesweb_portal":                       workspacesweb.ResourcePortal(),
+			"aws_workspacesweb_user_settings":                workspacesweb.ResourceUserSettings(),
+			"aws_workspacesweb_user_settings_association":    workspacesweb.ResourceUserSettingsAssociation(),
+
 			"aws_xray_encryption_config": xray.ResourceEncryptionConfig(),
 			"aws_xray_group":             xray.ResourceGroup(),
 			"aws_xray_sampling_rule":     xray.ResourceSamplingRule(),
@@ -1893,7 +2008,9 @@ func Provider() *schema.Provider {
 
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := conns.Config{
+		AbandonDeleteAfterAttempts:     d.Get("abandon_delete_after_attempts").(int),
 		AccessKey:                      d.Get("access_key").(string),
+		DebugLoggingConfig:             expandProviderDebugLogging(d.Get("debug_logging").([]interface{})),
 		DefaultTagsConfig:              expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -1903,6 +2020,7 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     d.Get("max_retries").(int),
 		Profile:                        d.Get("profile").(string),
+		ReadOnly:                       d.Get("read_only").(bool),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool) || d.Get("s3_force_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
@@ -1915,6 +2033,7 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		Token:                          d.Get("token").(string),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+		UserAgent:                      expandProviderUserAgent(d.Get("user_agent").([]interface{})),
 	}
 
 	if raw := d.Get("shared_config_files").([]interface{}); len(raw) != 0 {
@@ -1936,9 +2055,32 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		config.SharedCredentialsFiles = l
 	}
 
-	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		config.AssumeRole = expandAssumeRole(l[0].(map[string]interface{}))
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRole.RoleARN, config.AssumeRole.SessionName, config.AssumeRole.ExternalID)
+	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 {
+		if err := validateAssumeRoleChain(l); err != nil {
+			return nil, err
+		}
+
+		for i, tfMapRaw := range l {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			assumeRole, err := expandAssumeRole(tfMap)
+
+			if err != nil {
+				return nil, fmt.Errorf("assume_role.%d: %w", i, err)
+			}
+
+			if config.AssumeRole == nil {
+				config.AssumeRole = assumeRole
+			} else {
+				config.AssumeRoleChain = append(config.AssumeRoleChain, assumeRole)
+			}
+
+			log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
+		}
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -1946,6 +2088,18 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		return nil, err
 	}
 
+	dualStackOverrides, err := expandEndpointOverrides(endpointsSet.List(), "use_dualstack_endpoint")
+	if err != nil {
+		return nil, err
+	}
+	config.UseDualStackEndpointOverrides = dualStackOverrides
+
+	fipsOverrides, err := expandEndpointOverrides(endpointsSet.List(), "use_fips_endpoint")
+	if err != nil {
+		return nil, err
+	}
+	config.UseFIPSEndpointOverrides = fipsOverrides
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1963,25 +2117,23 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume prior to making API calls. Multiple roles are assumed in order, each using the credentials of the previous one.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
-					Type:          schema.TypeString,
-					Optional:      true,
-					Description:   "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
-					ValidateFunc:  ValidAssumeRoleDuration,
-					ConflictsWith: []string{"assume_role.0.duration_seconds"},
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: ValidAssumeRoleDuration,
 				},
 				"duration_seconds": {
-					Type:          schema.TypeInt,
-					Optional:      true,
-					Deprecated:    "Use assume_role.0.duration instead",
-					Description:   "The duration, in seconds, of the role session.",
-					ValidateFunc:  validation.IntBetween(900, 43200),
-					ConflictsWith: []string{"assume_role.0.duration"},
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Deprecated:   "Use assume_role.0.duration instead",
+					Description:  "The duration, in seconds, of the role session.",
+					ValidateFunc: validation.IntBetween(900, 43200),
 				},
 				"external_id": {
 					Type:        schema.TypeString,
@@ -1992,6 +2144,12 @@ func assumeRoleSchema() *schema.Schema {
 						validation.StringMatch(regexp.MustCompile(`[\w+=,.@:\/\-]*`), ""),
 					),
 				},
+				"external_id_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("AWS_ROLE_EXTERNAL_ID_FILE", nil),
+					Description: "Path to a file containing the external ID. Read each time the provider is configured. Ignored if external_id is set.",
+				},
 				"policy": {
 					Type:         schema.TypeString,
 					Optional:     true,
@@ -2051,6 +2209,19 @@ func endpointsSchema() *schema.Schema {
 		}
 	}
 
+	endpointsAttributes["use_dualstack_endpoint"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeBool},
+		Description: "Use this to override use_dualstack_endpoint for individual services",
+	}
+	endpointsAttributes["use_fips_endpoint"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeBool},
+		Description: "Use this to override use_fips_endpoint for individual services",
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -2060,7 +2231,25 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
-func expandAssumeRole(m map[string]interface{}) *awsbase.AssumeRole {
+// validateAssumeRoleChain checks that every role in a chain of assume_role blocks has a role_arn,
+// so that the chain is never silently shortened.
+func validateAssumeRoleChain(l []interface{}) error {
+	if len(l) < 2 {
+		return nil
+	}
+
+	for i, tfMapRaw := range l {
+		tfMap, _ := tfMapRaw.(map[string]interface{})
+
+		if v, _ := tfMap["role_arn"].(string); v == "" {
+			return fmt.Errorf("assume_role.%d: role_arn is required when multiple assume_role blocks are configured", i)
+		}
+	}
+
+	return nil
+}
+
+func expandAssumeRole(m map[string]interface{}) (*awsbase.AssumeRole, error) {
 	assumeRole := awsbase.AssumeRole{}
 
 	if v, ok := m["duration"].(string); ok && v != "" {
@@ -2069,11 +2258,23 @@ func expandAssumeRole(m map[string]interface{}) *awsbase.AssumeRole {
 	}
 
 	if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+		if assumeRole.Duration != 0 {
+			return nil, errors.New(`only one of "duration" or "duration_seconds" can be specified`)
+		}
+
 		assumeRole.Duration = time.Duration(v) * time.Second
 	}
 
 	if v, ok := m["external_id"].(string); ok && v != "" {
 		assumeRole.ExternalID = v
+	} else if v, ok := m["external_id_file"].(string); ok && v != "" {
+		externalID, err := readAssumeRoleExternalIDFile(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		assumeRole.ExternalID = externalID
 	}
 
 	if v, ok := m["policy"].(string); ok && v != "" {
@@ -2126,7 +2327,58 @@ func expandAssumeRole(m map[string]interface{}) *awsbase.AssumeRole {
 		}
 	}
 
-	return &assumeRole
+	return &assumeRole, nil
+}
+
+// readAssumeRoleExternalIDFile returns the external ID stored in a file, ignoring surrounding whitespace.
+// Reading the file at configuration time allows the external ID to be rotated without changing the configuration.
+func readAssumeRoleExternalIDFile(path string) (string, error) {
+	path, err := homedir.Expand(path)
+
+	if err != nil {
+		return "", fmt.Errorf("error expanding external_id_file path: %w", err)
+	}
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return "", fmt.Errorf("error reading external_id_file (%s): %w", path, err)
+	}
+
+	externalID := strings.TrimSpace(string(b))
+
+	if l := len(externalID); l < 2 || l > 1224 {
+		return "", fmt.Errorf("external ID in external_id_file (%s) must be between 2 and 1224 characters, got %d", path, l)
+	}
+
+	return externalID, nil
+}
+
+func expandProviderDebugLogging(l []interface{}) *conns.DebugLoggingConfig {
+	if len(l) == 0 {
+		return nil
+	}
+
+	debugLoggingConfig := &conns.DebugLoggingConfig{}
+
+	// An empty block enables debug logging with the default settings.
+	m, ok := l[0].(map[string]interface{})
+
+	if !ok {
+		return debugLoggingConfig
+	}
+
+	if v, ok := m["http_bodies"].(bool); ok {
+		debugLoggingConfig.HTTPBodies = v
+	}
+
+	if v, ok := m["redact"].(*schema.Set); ok {
+		for _, vRaw := range v.List() {
+			debugLoggingConfig.Redact = append(debugLoggingConfig.Redact, vRaw.(string))
+		}
+	}
+
+	return debugLoggingConfig
 }
 
 func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
@@ -2162,6 +2414,30 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 	return ignoreConfig
 }
 
+func expandProviderUserAgent(l []interface{}) awsbase.UserAgentProducts {
+	if len(l) == 0 {
+		return nil
+	}
+
+	userAgentProducts := make(awsbase.UserAgentProducts, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		userAgentProducts = append(userAgentProducts, awsbase.UserAgentProduct{
+			Name:    m["product_name"].(string),
+			Version: m["product_version"].(string),
+			Comment: m["comment"].(string),
+		})
+	}
+
+	return userAgentProducts
+}
+
 func expandEndpoints(endpointsSetList []interface{}, out map[string]string) error {
 	for _, endpointsSetI := range endpointsSetList {
 		endpoints := endpointsSetI.(map[string]interface{})
@@ -2201,3 +2477,31 @@ func expandEndpoints(endpointsSetList []interface{}, out map[string]string) erro
 
 	return nil
 }
+
+// expandEndpointOverrides returns the per-service boolean overrides configured by the specified
+// endpoints block attribute, keyed by service.
+func expandEndpointOverrides(endpointsSetList []interface{}, attribute string) (map[string]bool, error) {
+	out := make(map[string]bool)
+
+	for _, endpointsSetI := range endpointsSetList {
+		endpoints := endpointsSetI.(map[string]interface{})
+
+		overrides, ok := endpoints[attribute].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for hclKey, v := range overrides {
+			serviceKey, err := conns.ServiceForHCLKey(hclKey)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to assign %s override (%s): %w", attribute, hclKey, err)
+			}
+
+			out[serviceKey] = v.(bool)
+		}
+	}
+
+	return out, nil
+}
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -34,6 +36,43 @@ func TestExpandEndpoints(t *testing.T) {
 	}
 }
 
+func TestExpandEndpointOverrides(t *testing.T) {
+	endpoints := map[string]interface{}{
+		"use_fips_endpoint": map[string]interface{}{
+			"ec2":           true,
+			"elasticsearch": false,
+		},
+	}
+
+	results, err := expandEndpointOverrides([]interface{}{endpoints}, "use_fips_endpoint")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]bool{
+		conns.EC2:           true,
+		conns.Elasticsearch: false,
+	}
+
+	if len(results) != len(expected) {
+		t.Errorf("Expected %d overrides, got %d", len(expected), len(results))
+	}
+
+	for k, v := range expected {
+		if got, ok := results[k]; !ok || got != v {
+			t.Errorf("Expected override[%s] to be %t, got %v", k, v, results)
+		}
+	}
+
+	endpoints["use_fips_endpoint"] = map[string]interface{}{
+		"notaservice": true,
+	}
+
+	if _, err := expandEndpointOverrides([]interface{}{endpoints}, "use_fips_endpoint"); err == nil {
+		t.Error("Expected error for unknown service, got none")
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) {
 	testcases := []struct {
 		endpoints        map[string]string
@@ -188,3 +227,189 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandAssumeRoleExternalIDFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "external-id")
+
+	if err := os.WriteFile(path, []byte("  external-id-from-file\n"), 0600); err != nil {
+		t.Fatalf("error writing external ID file: %s", err)
+	}
+
+	testCases := []struct {
+		name      string
+		input     map[string]interface{}
+		expected  string
+		expectErr bool
+	}{
+		{
+			name: "file",
+			input: map[string]interface{}{
+				"external_id_file": path,
+			},
+			expected: "external-id-from-file",
+		},
+		{
+			name: "external_id takes precedence",
+			input: map[string]interface{}{
+				"external_id":      "external-id",
+				"external_id_file": path,
+			},
+			expected: "external-id",
+		},
+		{
+			name: "missing file",
+			input: map[string]interface{}{
+				"external_id_file": filepath.Join(t.TempDir(), "missing"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "duration conflict",
+			input: map[string]interface{}{
+				"duration":         "1h",
+				"duration_seconds": 3600,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assumeRole, err := expandAssumeRole(testCase.input)
+
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := assumeRole.ExternalID; got != testCase.expected {
+				t.Errorf("got external ID %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestExpandProviderUserAgent(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"product_name":    "team-networking",
+			"product_version": "",
+			"comment":         "",
+		},
+		map[string]interface{}{
+			"product_name":    "vpc-module",
+			"product_version": "1.2.3",
+			"comment":         "workspace production",
+		},
+	}
+
+	results := expandProviderUserAgent(input)
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 user agent products, got %d", len(results))
+	}
+
+	if got, expected := results.BuildUserAgentString(), "team-networking vpc-module/1.2.3 (workspace production)"; got != expected {
+		t.Errorf("got user agent %q, expected %q", got, expected)
+	}
+
+	if results := expandProviderUserAgent(nil); results != nil {
+		t.Errorf("expected nil user agent products, got %v", results)
+	}
+}
+
+func TestExpandProviderDebugLogging(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected *conns.DebugLoggingConfig
+	}{
+		{
+			Name: "no block",
+		},
+		{
+			Name:     "empty block",
+			Input:    []interface{}{nil},
+			Expected: &conns.DebugLoggingConfig{},
+		},
+		{
+			Name: "http_bodies",
+			Input: []interface{}{
+				map[string]interface{}{
+					"http_bodies": true,
+				},
+			},
+			Expected: &conns.DebugLoggingConfig{HTTPBodies: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := expandProviderDebugLogging(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestValidateAssumeRoleChain(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Input       []interface{}
+		ExpectError bool
+	}{
+		{
+			Name: "no blocks",
+		},
+		{
+			Name:  "single block without role_arn",
+			Input: []interface{}{nil},
+		},
+		{
+			Name: "chain",
+			Input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/first"},  //lintignore:AWSAT005
+				map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/second"}, //lintignore:AWSAT005
+			},
+		},
+		{
+			Name: "chain with empty first block",
+			Input: []interface{}{
+				nil,
+				map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/second"}, //lintignore:AWSAT005
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "chain without role_arn",
+			Input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/first"}, //lintignore:AWSAT005
+				map[string]interface{}{"role_arn": "", "session_name": "second"},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validateAssumeRoleChain(testCase.Input)
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, but received none")
+			}
+		})
+	}
+}
@@ -44,3 +44,32 @@ func FindScheduledAction(conn *applicationautoscaling.ApplicationAutoScaling, na
 
 	return result, nil
 }
+
+func FindScalingPolicy(conn *applicationautoscaling.ApplicationAutoScaling, name, serviceNamespace, resourceId, scalableDimension string) (*applicationautoscaling.ScalingPolicy, error) {
+	input := &applicationautoscaling.DescribeScalingPoliciesInput{
+		PolicyNames:       []*string{aws.String(name)},
+		ResourceId:        aws.String(resourceId),
+		ScalableDimension: aws.String(scalableDimension),
+		ServiceNamespace:  aws.String(serviceNamespace),
+	}
+
+	output, err := conn.DescribeScalingPolicies(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range output.ScalingPolicies {
+		if item == nil {
+			continue
+		}
+
+		if name == aws.StringValue(item.PolicyName) {
+			return item, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
@@ -0,0 +1,252 @@
+package appintegrations
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataIntegration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataIntegrationCreate,
+		Read:   resourceDataIntegrationRead,
+		Update: resourceDataIntegrationUpdate,
+		Delete: resourceDataIntegrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"kms_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"schedule_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_execution_from": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"object": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"schedule_expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+			"source_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceDataIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appintegrationsservice.CreateDataIntegrationInput{
+		KmsKey:         aws.String(d.Get("kms_key").(string)),
+		Name:           aws.String(name),
+		ScheduleConfig: expandScheduleConfiguration(d.Get("schedule_config").([]interface{})),
+		SourceURI:      aws.String(d.Get("source_uri").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating AppIntegrations Data Integration: %s", input)
+	output, err := conn.CreateDataIntegration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppIntegrations Data Integration (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceDataIntegrationRead(d, meta)
+}
+
+func resourceDataIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDataIntegrationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppIntegrations Data Integration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppIntegrations Data Integration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	d.Set("kms_key", output.KmsKey)
+	d.Set("name", output.Name)
+	if err := d.Set("schedule_config", flattenScheduleConfiguration(output.ScheduleConfiguration)); err != nil {
+		return fmt.Errorf("error setting schedule_config: %w", err)
+	}
+	d.Set("source_uri", output.SourceURI)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDataIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn
+
+	if d.HasChanges("description", "name") {
+		input := &appintegrationsservice.UpdateDataIntegrationInput{
+			Identifier: aws.String(d.Id()),
+			Name:       aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating AppIntegrations Data Integration: %s", input)
+		_, err := conn.UpdateDataIntegration(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating AppIntegrations Data Integration (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppIntegrations Data Integration (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDataIntegrationRead(d, meta)
+}
+
+func resourceDataIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn
+
+	log.Printf("[DEBUG] Deleting AppIntegrations Data Integration: %s", d.Id())
+	_, err := conn.DeleteDataIntegration(&appintegrationsservice.DeleteDataIntegrationInput{
+		DataIntegrationIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appintegrationsservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppIntegrations Data Integration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandScheduleConfiguration(tfList []interface{}) *appintegrationsservice.ScheduleConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &appintegrationsservice.ScheduleConfiguration{}
+
+	if v, ok := tfMap["first_execution_from"].(string); ok && v != "" {
+		apiObject.FirstExecutionFrom = aws.String(v)
+	}
+
+	if v, ok := tfMap["object"].(string); ok && v != "" {
+		apiObject.Object = aws.String(v)
+	}
+
+	if v, ok := tfMap["schedule_expression"].(string); ok && v != "" {
+		apiObject.ScheduleExpression = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenScheduleConfiguration(apiObject *appintegrationsservice.ScheduleConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"first_execution_from": aws.StringValue(apiObject.FirstExecutionFrom),
+		"object":               aws.StringValue(apiObject.Object),
+		"schedule_expression":  aws.StringValue(apiObject.ScheduleExpression),
+	}
+
+	return []interface{}{tfMap}
+}
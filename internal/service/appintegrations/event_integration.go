@@ -0,0 +1,226 @@
+package appintegrations
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEventIntegration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEventIntegrationCreate,
+		Read:   resourceEventIntegrationRead,
+		Update: resourceEventIntegrationUpdate,
+		Delete: resourceEventIntegrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"event_filter": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 256),
+								validation.StringMatch(regexp.MustCompile(`^aws\.partner\/.*$`), "must begin with aws.partner/"),
+							),
+						},
+					},
+				},
+			},
+			"eventbridge_bus": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9/\._\-]+$`), "must contain only alphanumeric characters, periods, underscores, hyphens and forward slashes"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceEventIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appintegrationsservice.CreateEventIntegrationInput{
+		EventBridgeBus: aws.String(d.Get("eventbridge_bus").(string)),
+		EventFilter:    expandEventFilter(d.Get("event_filter").([]interface{})),
+		Name:           aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating AppIntegrations Event Integration: %s", input)
+	_, err := conn.CreateEventIntegration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppIntegrations Event Integration (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceEventIntegrationRead(d, meta)
+}
+
+func resourceEventIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindEventIntegrationByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppIntegrations Event Integration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppIntegrations Event Integration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.EventIntegrationArn)
+	d.Set("description", output.Description)
+	if err := d.Set("event_filter", flattenEventFilter(output.EventFilter)); err != nil {
+		return fmt.Errorf("error setting event_filter: %w", err)
+	}
+	d.Set("eventbridge_bus", output.EventBridgeBus)
+	d.Set("name", output.Name)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEventIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn
+
+	if d.HasChange("description") {
+		input := &appintegrationsservice.UpdateEventIntegrationInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating AppIntegrations Event Integration: %s", input)
+		_, err := conn.UpdateEventIntegration(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating AppIntegrations Event Integration (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating AppIntegrations Event Integration (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEventIntegrationRead(d, meta)
+}
+
+func resourceEventIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppIntegrationsConn
+
+	log.Printf("[DEBUG] Deleting AppIntegrations Event Integration: %s", d.Id())
+	_, err := conn.DeleteEventIntegration(&appintegrationsservice.DeleteEventIntegrationInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appintegrationsservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppIntegrations Event Integration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEventFilter(tfList []interface{}) *appintegrationsservice.EventFilter {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &appintegrationsservice.EventFilter{}
+
+	if v, ok := tfMap["source"].(string); ok && v != "" {
+		apiObject.Source = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEventFilter(apiObject *appintegrationsservice.EventFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"source": aws.StringValue(apiObject.Source),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,59 @@
+package appintegrations
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDataIntegrationByID(conn *appintegrationsservice.AppIntegrationsService, id string) (*appintegrationsservice.GetDataIntegrationOutput, error) {
+	input := &appintegrationsservice.GetDataIntegrationInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetDataIntegration(input)
+
+	if tfawserr.ErrCodeEquals(err, appintegrationsservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindEventIntegrationByName(conn *appintegrationsservice.AppIntegrationsService, name string) (*appintegrationsservice.GetEventIntegrationOutput, error) {
+	input := &appintegrationsservice.GetEventIntegrationInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetEventIntegration(input)
+
+	if tfawserr.ErrCodeEquals(err, appintegrationsservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -61,6 +61,7 @@ func TestAccAppMesh_serial(t *testing.T) {
 			"listenerTls":                testAccVirtualNode_listenerTLS,
 			"listenerValidation":         testAccVirtualNode_listenerValidation,
 			"logging":                    testAccVirtualNode_logging,
+			"specJson":                   testAccVirtualNode_specJSON,
 			"tags":                       testAccVirtualNode_tags,
 		},
 		"VirtualRouter": {
@@ -60,10 +60,12 @@ func ResourceRoute() *schema.Resource {
 			},
 
 			"spec": {
-				Type:     schema.TypeList,
-				Required: true,
-				MinItems: 1,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"spec", "spec_json"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"grpc_route": {
@@ -439,6 +441,8 @@ func ResourceRoute() *schema.Resource {
 				Computed: true,
 			},
 
+			"spec_json": specJSONSchema(func() interface{} { return &appmesh.RouteSpec{} }),
+
 			"tags": tftags.TagsSchema(),
 
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -716,6 +720,15 @@ func resourceRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("mesh_owner"); ok {
 		req.MeshOwner = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("spec_json"); ok {
+		spec := &appmesh.RouteSpec{}
+
+		if err := expandSpecJSON(v.(string), spec); err != nil {
+			return fmt.Errorf("error expanding spec_json: %w", err)
+		}
+
+		req.Spec = spec
+	}
 
 	log.Printf("[DEBUG] Creating App Mesh route: %#v", req)
 	resp, err := conn.CreateRoute(req)
@@ -802,6 +815,14 @@ func resourceRouteRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting spec: %s", err)
 	}
 
+	specJSON, err := flattenSpecJSON(resp.Route.Spec)
+
+	if err != nil {
+		return fmt.Errorf("error flattening spec_json: %w", err)
+	}
+
+	d.Set("spec_json", specJSON)
+
 	tags, err := ListTags(conn, arn)
 
 	if err != nil {
@@ -825,7 +846,7 @@ func resourceRouteRead(d *schema.ResourceData, meta interface{}) error {
 func resourceRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppMeshConn
 
-	if d.HasChange("spec") {
+	if d.HasChanges("spec", "spec_json") {
 		_, v := d.GetChange("spec")
 		req := &appmesh.UpdateRouteInput{
 			MeshName:          aws.String(d.Get("mesh_name").(string)),
@@ -837,6 +858,17 @@ func resourceRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 			req.MeshOwner = aws.String(v.(string))
 		}
 
+		// The spec configuration block is computed when spec_json is configured, and vice versa.
+		if d.HasChange("spec_json") {
+			spec := &appmesh.RouteSpec{}
+
+			if err := expandSpecJSON(d.Get("spec_json").(string), spec); err != nil {
+				return fmt.Errorf("error expanding spec_json: %w", err)
+			}
+
+			req.Spec = spec
+		}
+
 		log.Printf("[DEBUG] Updating App Mesh route: %#v", req)
 		_, err := conn.UpdateRoute(req)
 		if err != nil {
@@ -0,0 +1,105 @@
+package appmesh
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// specJSONSchema returns the schema for the spec_json argument, an alternative to the
+// spec configuration block that accepts the App Mesh API JSON representation of the spec.
+// newSpec must return a pointer to a new, empty API spec object (e.g. &appmesh.RouteSpec{}).
+func specJSONSchema(newSpec func() interface{}) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"spec", "spec_json"},
+		ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+			if err := expandSpecJSON(v.(string), newSpec()); err != nil {
+				errors = append(errors, fmt.Errorf("%q contains an invalid App Mesh spec: %w", k, err))
+			}
+
+			return
+		},
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			equal, _ := EquivalentSpecJSON(old, new, newSpec)
+
+			return equal
+		},
+	}
+}
+
+// EquivalentSpecJSON determines equality between two App Mesh spec JSON strings
+// by comparing their canonical API representations.
+func EquivalentSpecJSON(str1, str2 string, newSpec func() interface{}) (bool, error) {
+	if str1 == "" {
+		str1 = "{}"
+	}
+
+	if str2 == "" {
+		str2 = "{}"
+	}
+
+	spec1, spec2 := newSpec(), newSpec()
+
+	if err := expandSpecJSON(str1, spec1); err != nil {
+		return false, err
+	}
+
+	canonicalJson1, err := jsonutil.BuildJSON(spec1)
+
+	if err != nil {
+		return false, err
+	}
+
+	if err := expandSpecJSON(str2, spec2); err != nil {
+		return false, err
+	}
+
+	canonicalJson2, err := jsonutil.BuildJSON(spec2)
+
+	if err != nil {
+		return false, err
+	}
+
+	equal := bytes.Equal(canonicalJson1, canonicalJson2)
+
+	if !equal {
+		log.Printf("[DEBUG] Canonical App Mesh spec JSON are not equal.\nFirst: %s\nSecond: %s\n", canonicalJson1, canonicalJson2)
+	}
+
+	return equal, nil
+}
+
+// expandSpecJSON decodes the App Mesh API JSON representation of a spec into spec.
+// Fields not present in the API object are an error, so that misspelled fields are caught at plan time.
+func expandSpecJSON(s string, spec interface{}) error {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(spec); err != nil {
+		return fmt.Errorf("error decoding JSON: %w", err)
+	}
+
+	if decoder.More() {
+		return fmt.Errorf("error decoding JSON: unexpected data after top-level value")
+	}
+
+	return nil
+}
+
+func flattenSpecJSON(spec interface{}) (string, error) {
+	b, err := jsonutil.BuildJSON(spec)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,93 @@
+package appmesh_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	tfappmesh "github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
+)
+
+func TestEquivalentSpecJSON(t *testing.T) {
+	newRouteSpec := func() interface{} { return &appmesh.RouteSpec{} }
+
+	testCases := []struct {
+		Name             string
+		ApiJson          string
+		ConfigJson       string
+		ExpectEquivalent bool
+		ExpectError      bool
+	}{
+		{
+			Name:             "empty",
+			ApiJson:          ``,
+			ConfigJson:       ``,
+			ExpectEquivalent: true,
+		},
+		{
+			Name:             "empty and empty object",
+			ApiJson:          `{}`,
+			ConfigJson:       ``,
+			ExpectEquivalent: true,
+		},
+		{
+			Name:    "whitespace and key order",
+			ApiJson: `{"priority":100,"tcpRoute":{"action":{"weightedTargets":[{"virtualNode":"vn1","weight":100}]}}}`,
+			ConfigJson: `{
+  "tcpRoute": {
+    "action": {
+      "weightedTargets": [
+        {
+          "weight": 100,
+          "virtualNode": "vn1"
+        }
+      ]
+    }
+  },
+  "priority": 100
+}`,
+			ExpectEquivalent: true,
+		},
+		{
+			Name:        "unknown fields",
+			ApiJson:     `{"priority":100}`,
+			ConfigJson:  `{"priority":100,"notARealField":true}`,
+			ExpectError: true,
+		},
+		{
+			Name:        "trailing data",
+			ApiJson:     `{"priority":100}`,
+			ConfigJson:  `{"priority":100} {"priority":200}`,
+			ExpectError: true,
+		},
+		{
+			Name:             "different values",
+			ApiJson:          `{"priority":100}`,
+			ConfigJson:       `{"priority":200}`,
+			ExpectEquivalent: false,
+		},
+		{
+			Name:        "invalid JSON",
+			ApiJson:     `{"priority":100}`,
+			ConfigJson:  `{"priority":`,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := tfappmesh.EquivalentSpecJSON(testCase.ConfigJson, testCase.ApiJson, newRouteSpec)
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, but received none")
+			}
+
+			if got != testCase.ExpectEquivalent {
+				t.Errorf("got %t, expected %t", got, testCase.ExpectEquivalent)
+			}
+		})
+	}
+}
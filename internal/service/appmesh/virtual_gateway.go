@@ -53,10 +53,12 @@ func ResourceVirtualGateway() *schema.Resource {
 			},
 
 			"spec": {
-				Type:     schema.TypeList,
-				Required: true,
-				MinItems: 1,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"spec", "spec_json"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"backend_defaults": {
@@ -677,6 +679,8 @@ func ResourceVirtualGateway() *schema.Resource {
 				Computed: true,
 			},
 
+			"spec_json": specJSONSchema(func() interface{} { return &appmesh.VirtualGatewaySpec{} }),
+
 			"tags": tftags.TagsSchema(),
 
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -700,6 +704,15 @@ func resourceVirtualGatewayCreate(d *schema.ResourceData, meta interface{}) erro
 	if v, ok := d.GetOk("mesh_owner"); ok {
 		input.MeshOwner = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("spec_json"); ok {
+		spec := &appmesh.VirtualGatewaySpec{}
+
+		if err := expandSpecJSON(v.(string), spec); err != nil {
+			return fmt.Errorf("error expanding spec_json: %w", err)
+		}
+
+		input.Spec = spec
+	}
 
 	log.Printf("[DEBUG] Creating App Mesh virtual gateway: %s", input)
 	output, err := conn.CreateVirtualGateway(input)
@@ -783,6 +796,14 @@ func resourceVirtualGatewayRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting spec: %w", err)
 	}
 
+	specJSON, err := flattenSpecJSON(virtualGateway.Spec)
+
+	if err != nil {
+		return fmt.Errorf("error flattening spec_json: %w", err)
+	}
+
+	d.Set("spec_json", specJSON)
+
 	tags, err := ListTags(conn, arn)
 
 	if err != nil {
@@ -806,7 +827,7 @@ func resourceVirtualGatewayRead(d *schema.ResourceData, meta interface{}) error
 func resourceVirtualGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppMeshConn
 
-	if d.HasChange("spec") {
+	if d.HasChanges("spec", "spec_json") {
 		input := &appmesh.UpdateVirtualGatewayInput{
 			MeshName:           aws.String(d.Get("mesh_name").(string)),
 			Spec:               expandAppmeshVirtualGatewaySpec(d.Get("spec").([]interface{})),
@@ -816,6 +837,17 @@ func resourceVirtualGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 			input.MeshOwner = aws.String(v.(string))
 		}
 
+		// The spec configuration block is computed when spec_json is configured, and vice versa.
+		if d.HasChange("spec_json") {
+			spec := &appmesh.VirtualGatewaySpec{}
+
+			if err := expandSpecJSON(d.Get("spec_json").(string), spec); err != nil {
+				return fmt.Errorf("error expanding spec_json: %w", err)
+			}
+
+			input.Spec = spec
+		}
+
 		log.Printf("[DEBUG] Updating App Mesh virtual gateway: %s", input)
 		_, err := conn.UpdateVirtualGateway(input)
 
@@ -56,10 +56,12 @@ func ResourceVirtualNode() *schema.Resource {
 			},
 
 			"spec": {
-				Type:     schema.TypeList,
-				Required: true,
-				MinItems: 1,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"spec", "spec_json"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"backend": {
@@ -870,6 +872,8 @@ func ResourceVirtualNode() *schema.Resource {
 				Computed: true,
 			},
 
+			"spec_json": specJSONSchema(func() interface{} { return &appmesh.VirtualNodeSpec{} }),
+
 			"tags": tftags.TagsSchema(),
 
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -1072,6 +1076,15 @@ func resourceVirtualNodeCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("mesh_owner"); ok {
 		req.MeshOwner = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("spec_json"); ok {
+		spec := &appmesh.VirtualNodeSpec{}
+
+		if err := expandSpecJSON(v.(string), spec); err != nil {
+			return fmt.Errorf("error expanding spec_json: %w", err)
+		}
+
+		req.Spec = spec
+	}
 
 	log.Printf("[DEBUG] Creating App Mesh virtual node: %s", req)
 	resp, err := conn.CreateVirtualNode(req)
@@ -1157,6 +1170,14 @@ func resourceVirtualNodeRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting spec: %w", err)
 	}
 
+	specJSON, err := flattenSpecJSON(resp.VirtualNode.Spec)
+
+	if err != nil {
+		return fmt.Errorf("error flattening spec_json: %w", err)
+	}
+
+	d.Set("spec_json", specJSON)
+
 	tags, err := ListTags(conn, arn)
 
 	if err != nil {
@@ -1180,7 +1201,7 @@ func resourceVirtualNodeRead(d *schema.ResourceData, meta interface{}) error {
 func resourceVirtualNodeUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppMeshConn
 
-	if d.HasChange("spec") {
+	if d.HasChanges("spec", "spec_json") {
 		_, v := d.GetChange("spec")
 		req := &appmesh.UpdateVirtualNodeInput{
 			MeshName:        aws.String(d.Get("mesh_name").(string)),
@@ -1191,6 +1212,17 @@ func resourceVirtualNodeUpdate(d *schema.ResourceData, meta interface{}) error {
 			req.MeshOwner = aws.String(v.(string))
 		}
 
+		// The spec configuration block is computed when spec_json is configured, and vice versa.
+		if d.HasChange("spec_json") {
+			spec := &appmesh.VirtualNodeSpec{}
+
+			if err := expandSpecJSON(d.Get("spec_json").(string), spec); err != nil {
+				return fmt.Errorf("error expanding spec_json: %w", err)
+			}
+
+			req.Spec = spec
+		}
+
 		log.Printf("[DEBUG] Updating App Mesh virtual node: %s", req)
 		_, err := conn.UpdateVirtualNode(req)
 
@@ -80,3 +80,33 @@ func FindCustomDomain(ctx context.Context, conn *apprunner.AppRunner, domainName
 
 	return customDomain, nil
 }
+
+func FindOperationSummariesByServiceARN(ctx context.Context, conn *apprunner.AppRunner, serviceArn string) ([]*apprunner.OperationSummary, error) {
+	input := &apprunner.ListOperationsInput{
+		ServiceArn: aws.String(serviceArn),
+	}
+
+	var operations []*apprunner.OperationSummary
+
+	err := conn.ListOperationsPagesWithContext(ctx, input, func(page *apprunner.ListOperationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, o := range page.OperationSummaryList {
+			if o == nil {
+				continue
+			}
+
+			operations = append(operations, o)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return operations, nil
+}
@@ -0,0 +1,121 @@
+package apprunner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceServiceOperations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceOperationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"operations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ended_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"started_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceServiceOperationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+
+	serviceArn := d.Get("service_arn").(string)
+
+	operations, err := FindOperationSummariesByServiceARN(ctx, conn, serviceArn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing App Runner Service (%s) operations: %w", serviceArn, err))
+	}
+
+	d.SetId(serviceArn)
+
+	if err := d.Set("operations", flattenAppRunnerOperationSummaries(operations)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting operations: %w", err))
+	}
+
+	return nil
+}
+
+func flattenAppRunnerOperationSummaries(apiObjects []*apprunner.OperationSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id":         aws.StringValue(apiObject.Id),
+			"status":     aws.StringValue(apiObject.Status),
+			"target_arn": aws.StringValue(apiObject.TargetArn),
+			"type":       aws.StringValue(apiObject.Type),
+		}
+
+		if v := apiObject.EndedAt; v != nil {
+			tfMap["ended_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.StartedAt; v != nil {
+			tfMap["started_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.UpdatedAt; v != nil {
+			tfMap["updated_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
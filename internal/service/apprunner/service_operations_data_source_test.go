@@ -0,0 +1,45 @@
+package apprunner_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAppRunnerServiceOperationsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_apprunner_service_operations.test"
+	resourceName := "aws_apprunner_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckAppRunner(t) },
+		ErrorCheck: acctest.ErrorCheck(t, apprunner.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceOperationsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "operations.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "operations.0.type", apprunner.OperationTypeCreateService),
+					resource.TestCheckResourceAttr(dataSourceName, "operations.0.status", apprunner.OperationStatusSucceeded),
+					resource.TestCheckResourceAttrPair(dataSourceName, "operations.0.target_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "operations.0.started_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceOperationsDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAppRunnerService_imageRepository(rName),
+		`
+data "aws_apprunner_service_operations" "test" {
+  service_arn = aws_apprunner_service.test.arn
+}
+`)
+}
@@ -3,3 +3,29 @@ package autoscaling
 const (
 	TagResourceTypeGroup = `auto-scaling-group`
 )
+
+const (
+	LifecycleHookDefaultResultAbandon  = "ABANDON"
+	LifecycleHookDefaultResultContinue = "CONTINUE"
+)
+
+func LifecycleHookDefaultResult_Values() []string {
+	return []string{
+		LifecycleHookDefaultResultAbandon,
+		LifecycleHookDefaultResultContinue,
+	}
+}
+
+// Lifecycle hooks on a group with a warm pool are invoked with these same
+// transitions as instances move into and out of the warm pool.
+const (
+	LifecycleHookLifecycleTransitionInstanceLaunching   = "autoscaling:EC2_INSTANCE_LAUNCHING"
+	LifecycleHookLifecycleTransitionInstanceTerminating = "autoscaling:EC2_INSTANCE_TERMINATING"
+)
+
+func LifecycleHookLifecycleTransition_Values() []string {
+	return []string{
+		LifecycleHookLifecycleTransitionInstanceLaunching,
+		LifecycleHookLifecycleTransitionInstanceTerminating,
+	}
+}
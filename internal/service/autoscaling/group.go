@@ -27,6 +27,7 @@ import ( // nosemgrep: aws-sdk-go-multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -377,6 +378,18 @@ func ResourceGroup() *schema.Resource {
 				Default:  false,
 			},
 
+			"propagate_default_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"propagated_default_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"target_group_arns": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -399,29 +412,34 @@ func ResourceGroup() *schema.Resource {
 							Required: true,
 						},
 						"default_result": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(LifecycleHookDefaultResult_Values(), false),
 						},
 						"heartbeat_timeout": {
-							Type:     schema.TypeInt,
-							Optional: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(30, 7200),
 						},
 						"lifecycle_transition": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(LifecycleHookLifecycleTransition_Values(), false),
 						},
 						"notification_metadata": {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
 						"notification_target_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validLifecycleHookNotificationTargetARN,
 						},
 						"role_arn": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
 						},
 					},
 				},
@@ -609,6 +627,7 @@ func ResourceGroup() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			resourceGroupCustomizeDiffPropagatedDefaultTags,
 		),
 	}
 }
@@ -714,6 +733,11 @@ func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
 		createOpts.Tags = Tags(KeyValueTags(v, asgName, TagResourceTypeGroup).IgnoreAWS())
 	}
 
+	if d.Get("propagate_default_tags").(bool) {
+		defaultTags := groupPropagatedDefaultTags(meta, d.Get("tag"), d.Get("tags"))
+		createOpts.Tags = append(createOpts.Tags, Tags(KeyValueTags(expandGroupPropagatedDefaultTags(defaultTags), asgName, TagResourceTypeGroup))...)
+	}
+
 	if v, ok := d.GetOk("capacity_rebalance"); ok {
 		createOpts.CapacityRebalance = aws.Bool(v.(bool))
 	}
@@ -894,6 +918,18 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting suspended_processes: %s", err)
 	}
 
+	// Record the tags actually applied to the group for the previously propagated keys as well as
+	// the currently configured default tags, so that removed default tags are planned for removal.
+	propagatedDefaultTags := tftags.New(d.Get("propagated_default_tags").(map[string]interface{}))
+
+	if d.Get("propagate_default_tags").(bool) {
+		propagatedDefaultTags = propagatedDefaultTags.Merge(groupPropagatedDefaultTags(meta, d.Get("tag"), d.Get("tags")))
+	}
+
+	if err := d.Set("propagated_default_tags", KeyValueTags(g.Tags, d.Id(), TagResourceTypeGroup).Only(propagatedDefaultTags).Map()); err != nil {
+		return fmt.Errorf("error setting propagated_default_tags: %w", err)
+	}
+
 	var tagOk, tagsOk bool
 	var v interface{}
 
@@ -916,7 +952,7 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if !tagOk && !tagsOk {
-		if err := d.Set("tag", ListOfMap(KeyValueTags(g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig))); err != nil {
+		if err := d.Set("tag", ListOfMap(KeyValueTags(g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Ignore(propagatedDefaultTags))); err != nil {
 			return fmt.Errorf("error setting tag: %w", err)
 		}
 	}
@@ -1123,17 +1159,20 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		opts.ServiceLinkedRoleARN = aws.String(d.Get("service_linked_role_arn").(string))
 	}
 
-	if d.HasChanges("tag", "tags") {
+	if d.HasChanges("tag", "tags", "propagated_default_tags") {
 		oTagRaw, nTagRaw := d.GetChange("tag")
 		oTagsRaw, nTagsRaw := d.GetChange("tags")
+		oDefaultTagsRaw, nDefaultTagsRaw := d.GetChange("propagated_default_tags")
 
 		oTag := KeyValueTags(oTagRaw, d.Id(), TagResourceTypeGroup)
 		oTags := KeyValueTags(oTagsRaw, d.Id(), TagResourceTypeGroup)
-		oldTags := Tags(oTag.Merge(oTags))
+		oDefaultTags := KeyValueTags(expandGroupPropagatedDefaultTags(tftags.New(oDefaultTagsRaw)), d.Id(), TagResourceTypeGroup)
+		oldTags := Tags(oDefaultTags.Merge(oTag).Merge(oTags))
 
 		nTag := KeyValueTags(nTagRaw, d.Id(), TagResourceTypeGroup)
 		nTags := KeyValueTags(nTagsRaw, d.Id(), TagResourceTypeGroup)
-		newTags := Tags(nTag.Merge(nTags))
+		nDefaultTags := KeyValueTags(expandGroupPropagatedDefaultTags(tftags.New(nDefaultTagsRaw)), d.Id(), TagResourceTypeGroup)
+		newTags := Tags(nDefaultTags.Merge(nTag).Merge(nTags))
 
 		if err := UpdateTags(conn, d.Id(), TagResourceTypeGroup, oldTags, newTags); err != nil {
 			return fmt.Errorf("error updating tags for Auto Scaling Group (%s): %w", d.Id(), err)
@@ -2365,3 +2404,52 @@ func flattenLaunchTemplateSpecification(lt *autoscaling.LaunchTemplateSpecificat
 
 	return result
 }
+
+// resourceGroupCustomizeDiffPropagatedDefaultTags plans changes to the provider default tags
+// propagated to the group, which are not otherwise visible in the resource's configuration.
+func resourceGroupCustomizeDiffPropagatedDefaultTags(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	newTags := tftags.New(map[string]string{})
+
+	if diff.Get("propagate_default_tags").(bool) {
+		newTags = groupPropagatedDefaultTags(meta, diff.Get("tag"), diff.Get("tags"))
+	}
+
+	if oldTags := tftags.New(diff.Get("propagated_default_tags").(map[string]interface{})); !oldTags.Equal(newTags) {
+		return diff.SetNew("propagated_default_tags", newTags.Map())
+	}
+
+	return nil
+}
+
+// groupPropagatedDefaultTags returns the provider default tags to be propagated to the group,
+// excluding keys configured in tag or tags and keys ignored by the provider.
+func groupPropagatedDefaultTags(meta interface{}, tagRaw, tagsRaw interface{}) tftags.KeyValueTags {
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	defaultTags := defaultTagsConfig.GetTags()
+
+	if defaultTags == nil {
+		return tftags.New(map[string]string{})
+	}
+
+	configuredTags := KeyValueTags(tagRaw, "", "").Merge(KeyValueTags(tagsRaw, "", ""))
+
+	return defaultTags.Ignore(configuredTags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+}
+
+// expandGroupPropagatedDefaultTags returns the tags in the format of the tag configuration block,
+// with each tag propagated to instances launched in the group.
+func expandGroupPropagatedDefaultTags(tags tftags.KeyValueTags) []interface{} {
+	tfList := make([]interface{}, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tfList = append(tfList, map[string]interface{}{
+			"key":                 k,
+			"value":               v,
+			"propagate_at_launch": true,
+		})
+	}
+
+	return tfList
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -330,6 +331,100 @@ func TestAccAutoScalingGroup_tags(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingGroup_propagateDefaultTags(t *testing.T) {
+	var providers []*schema.Provider
+	var group autoscaling.Group
+	resourceName := "aws_autoscaling_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		CheckDestroy:      testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccGroupConfig_propagateDefaultTags(rName, true),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "propagate_default_tags", "true"),
+					resource.TestCheckResourceAttr(resourceName, "propagated_default_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "propagated_default_tags.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+					testAccCheckAutoscalingTags(&group.Tags, "providerkey1", map[string]interface{}{
+						"value":               "providervalue1",
+						"propagate_at_launch": true,
+					}),
+					testAccCheckAutoscalingTags(&group.Tags, "Name", map[string]interface{}{
+						"value":               rName,
+						"propagate_at_launch": true,
+					}),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("providerkey1", "providervalue1updated", "Name", "provider"),
+					testAccGroupConfig_propagateDefaultTags(rName, true),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "propagated_default_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "propagated_default_tags.providerkey1", "providervalue1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+					testAccCheckAutoscalingTags(&group.Tags, "providerkey1", map[string]interface{}{
+						"value":               "providervalue1updated",
+						"propagate_at_launch": true,
+					}),
+					testAccCheckAutoscalingTags(&group.Tags, "Name", map[string]interface{}{
+						"value":               rName,
+						"propagate_at_launch": true,
+					}),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1updated"),
+					testAccGroupConfig_propagateDefaultTags(rName, false),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "propagate_default_tags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "propagated_default_tags.%", "0"),
+					testAccCheckAutoscalingTagNotExists(&group.Tags, "providerkey1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1updated"),
+					testAccGroupConfig_propagateDefaultTags(rName, true),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "propagated_default_tags.%", "1"),
+					testAccCheckAutoscalingTags(&group.Tags, "providerkey1", map[string]interface{}{
+						"value":               "providervalue1updated",
+						"propagate_at_launch": true,
+					}),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags0(),
+					testAccGroupConfig_propagateDefaultTags(rName, true),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "propagated_default_tags.%", "0"),
+					testAccCheckAutoscalingTagNotExists(&group.Tags, "providerkey1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingGroup_vpcUpdates(t *testing.T) {
 	var group autoscaling.Group
 
@@ -2491,6 +2586,42 @@ resource "aws_autoscaling_group" "bar" {
 `, name, name)
 }
 
+func testAccGroupConfig_propagateDefaultTags(rName string, propagateDefaultTags bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_launch_configuration" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.test.id
+  instance_type = "t2.micro"
+}
+
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  max_size             = 0
+  min_size             = 0
+  launch_configuration = aws_launch_configuration.test.name
+
+  propagate_default_tags = %[2]t
+
+  tag {
+    key                 = "Name"
+    value               = %[1]q
+    propagate_at_launch = true
+  }
+}
+`, rName, propagateDefaultTags))
+}
+
 func testAccGroupUpdateConfig(name string) string {
 	return acctest.ConfigAvailableAZsNoOptInDefaultExclude() +
 		fmt.Sprintf(`
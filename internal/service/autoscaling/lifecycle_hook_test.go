@@ -62,6 +62,64 @@ func TestAccAutoScalingLifecycleHook_omitDefaultResult(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingLifecycleHook_update(t *testing.T) {
+	resourceName := "aws_autoscaling_lifecycle_hook.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLifecycleHookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecycleHookConfig_update(rName, "CONTINUE", 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecycleHookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_result", "CONTINUE"),
+					resource.TestCheckResourceAttr(resourceName, "heartbeat_timeout", "300"),
+				),
+			},
+			{
+				Config: testAccLifecycleHookConfig_update(rName, "ABANDON", 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecycleHookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_result", "ABANDON"),
+					resource.TestCheckResourceAttr(resourceName, "heartbeat_timeout", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingLifecycleHook_warmPool(t *testing.T) {
+	resourceName := "aws_autoscaling_lifecycle_hook.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLifecycleHookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecycleHookConfig_warmPool(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLifecycleHookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_result", "CONTINUE"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_transition", "autoscaling:EC2_INSTANCE_LAUNCHING"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccLifecycleHookImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckLifecycleHookExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -324,3 +382,61 @@ EOF
 }
 `, name, rInt, rInt, rInt, name, rInt)
 }
+
+func testAccLifecycleHookBaseConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinuxHvmEbsAmi(), acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_launch_configuration" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t3.nano"
+}
+`, rName))
+}
+
+func testAccLifecycleHookConfig_update(rName, defaultResult string, heartbeatTimeout int) string {
+	return acctest.ConfigCompose(testAccLifecycleHookBaseConfig(rName), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  max_size             = 0
+  min_size             = 0
+  force_delete         = true
+  launch_configuration = aws_launch_configuration.test.name
+}
+
+resource "aws_autoscaling_lifecycle_hook" "test" {
+  name                   = %[1]q
+  autoscaling_group_name = aws_autoscaling_group.test.name
+  default_result         = %[2]q
+  heartbeat_timeout      = %[3]d
+  lifecycle_transition   = "autoscaling:EC2_INSTANCE_LAUNCHING"
+}
+`, rName, defaultResult, heartbeatTimeout))
+}
+
+func testAccLifecycleHookConfig_warmPool(rName string) string {
+	return acctest.ConfigCompose(testAccLifecycleHookBaseConfig(rName), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones   = [data.aws_availability_zones.available.names[0]]
+  name                 = %[1]q
+  max_size             = 1
+  min_size             = 0
+  desired_capacity     = 0
+  force_delete         = true
+  launch_configuration = aws_launch_configuration.test.name
+
+  warm_pool {
+    pool_state = "Stopped"
+    min_size   = 0
+  }
+}
+
+resource "aws_autoscaling_lifecycle_hook" "test" {
+  name                   = %[1]q
+  autoscaling_group_name = aws_autoscaling_group.test.name
+  default_result         = "CONTINUE"
+  heartbeat_timeout      = 300
+  lifecycle_transition   = "autoscaling:EC2_INSTANCE_LAUNCHING"
+}
+`, rName))
+}
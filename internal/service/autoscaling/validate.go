@@ -3,6 +3,10 @@ package autoscaling
 import (
 	"fmt"
 	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
 )
 
 func validScheduleTimestamp(v interface{}, k string) (ws []string, errors []error) {
@@ -15,3 +19,31 @@ func validScheduleTimestamp(v interface{}, k string) (ws []string, errors []erro
 
 	return
 }
+
+// validLifecycleHookNotificationTargetARN validates that a lifecycle hook
+// notification target is an SQS queue or SNS topic ARN.
+func validLifecycleHookNotificationTargetARN(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == "" {
+		return
+	}
+
+	parsedARN, err := arn.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %w", k, value, err))
+		return
+	}
+
+	switch parsedARN.Service {
+	case sns.EndpointsID, sqs.EndpointsID:
+	default:
+		errors = append(errors, fmt.Errorf("%q (%s) must be an SNS topic or SQS queue ARN, got service %q", k, value, parsedARN.Service))
+	}
+
+	return
+}
@@ -0,0 +1,31 @@
+package autoscaling
+
+import (
+	"testing"
+)
+
+func TestValidLifecycleHookNotificationTargetARN(t *testing.T) {
+	validARNs := []string{
+		"arn:aws:sqs:us-west-2:123456789012:my-queue",  //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sns:us-west-2:123456789012:my-topic",  //lintignore:AWSAT003,AWSAT005
+		"arn:aws-cn:sns:cn-north-1:123456789012:topic", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validLifecycleHookNotificationTargetARN(v, "notification_target_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid notification target ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"arn:aws:lambda:us-west-2:123456789012:function:my-function", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:s3:::my-bucket", //lintignore:AWSAT005
+		"not-an-arn",
+	}
+	for _, v := range invalidARNs {
+		_, errors := validLifecycleHookNotificationTargetARN(v, "notification_target_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid notification target ARN", v)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package backup
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -161,10 +163,48 @@ func ResourcePlan() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourcePlanCustomizeDiff,
+		),
 	}
 }
 
+// Continuous backups can be retained for at most 35 days.
+const planContinuousBackupMaxDeleteAfterDays = 35
+
+func resourcePlanCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("rule") {
+		return nil
+	}
+
+	for _, tfMapRaw := range diff.Get("rule").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		ruleName := tfMap["rule_name"].(string)
+
+		if startWindow, completionWindow := tfMap["start_window"].(int), tfMap["completion_window"].(int); completionWindow <= startWindow {
+			return fmt.Errorf("rule (%s): completion_window (%d) must be greater than start_window (%d)", ruleName, completionWindow, startWindow)
+		}
+
+		if !tfMap["enable_continuous_backup"].(bool) {
+			continue
+		}
+
+		if v, ok := tfMap["lifecycle"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if deleteAfter := v[0].(map[string]interface{})["delete_after"].(int); deleteAfter > planContinuousBackupMaxDeleteAfterDays {
+				return fmt.Errorf("rule (%s): lifecycle delete_after (%d) must be at most %d days when enable_continuous_backup is true", ruleName, deleteAfter, planContinuousBackupMaxDeleteAfterDays)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourcePlanCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).BackupConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -595,7 +595,7 @@ func TestAccBackupPlan_enableContinuousBackup(t *testing.T) {
 		CheckDestroy: testAccCheckPlanDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPlanEnableContinuousBackupConfig(rName),
+				Config: testAccPlanEnableContinuousBackupConfig(rName, 35),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPlanExists(resourceName, &plan),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "backup", regexp.MustCompile(`backup-plan:.+`)),
@@ -622,6 +622,40 @@ func TestAccBackupPlan_enableContinuousBackup(t *testing.T) {
 	})
 }
 
+func TestAccBackupPlan_windowValidation(t *testing.T) {
+	rName := fmt.Sprintf("tf-testacc-backup-%s", sdkacctest.RandString(14))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, backup.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPlanWindowsConfig(rName, 120, 120),
+				ExpectError: regexp.MustCompile(`completion_window \(120\) must be greater than start_window \(120\)`),
+			},
+		},
+	})
+}
+
+func TestAccBackupPlan_continuousBackupRetentionValidation(t *testing.T) {
+	rName := fmt.Sprintf("tf-testacc-backup-%s", sdkacctest.RandString(14))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, backup.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPlanEnableContinuousBackupConfig(rName, 36),
+				ExpectError: regexp.MustCompile(`lifecycle delete_after \(36\) must be at most 35 days when enable_continuous_backup is true`),
+			},
+		},
+	})
+}
+
 func TestAccBackupPlan_disappears(t *testing.T) {
 	var plan backup.GetBackupPlanOutput
 	resourceName := "aws_backup_plan.test"
@@ -1135,7 +1169,7 @@ resource "aws_backup_plan" "test" {
 `, rName)
 }
 
-func testAccPlanEnableContinuousBackupConfig(rName string) string {
+func testAccPlanEnableContinuousBackupConfig(rName string, deleteAfter int) string {
 	return fmt.Sprintf(`
 resource "aws_backup_vault" "test" {
   name = %[1]q
@@ -1151,9 +1185,29 @@ resource "aws_backup_plan" "test" {
     enable_continuous_backup = true
 
     lifecycle {
-      delete_after = 35
+      delete_after = %[2]d
     }
   }
 }
-`, rName)
+`, rName, deleteAfter)
+}
+
+func testAccPlanWindowsConfig(rName string, startWindow, completionWindow int) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_plan" "test" {
+  name = %[1]q
+
+  rule {
+    rule_name         = %[1]q
+    target_vault_name = aws_backup_vault.test.name
+    schedule          = "cron(0 12 * * ? *)"
+    start_window      = %[2]d
+    completion_window = %[3]d
+  }
+}
+`, rName, startWindow, completionWindow)
 }
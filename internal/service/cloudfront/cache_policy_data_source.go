@@ -147,6 +147,7 @@ func dataSourceCachePolicyRead(d *schema.ResourceData, meta interface{}) error {
 		cachePolicyID = v.(string)
 	} else {
 		name := d.Get("name").(string)
+		var managedPolicyID string
 		input := &cloudfront.ListCachePoliciesInput{}
 
 		err := ListCachePoliciesPages(conn, input, func(page *cloudfront.ListCachePoliciesOutput, lastPage bool) bool {
@@ -155,10 +156,15 @@ func dataSourceCachePolicyRead(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			for _, policySummary := range page.CachePolicyList.Items {
-				if cachePolicy := policySummary.CachePolicy; aws.StringValue(cachePolicy.CachePolicyConfig.Name) == name {
+				cachePolicy := policySummary.CachePolicy
+
+				switch policyName := aws.StringValue(cachePolicy.CachePolicyConfig.Name); {
+				case policyName == name:
 					cachePolicyID = aws.StringValue(cachePolicy.Id)
 
 					return false
+				case aws.StringValue(policySummary.Type) == cloudfront.CachePolicyTypeManaged && policyName == managedPolicyNamePrefix+name:
+					managedPolicyID = aws.StringValue(cachePolicy.Id)
 				}
 			}
 
@@ -169,6 +175,11 @@ func dataSourceCachePolicyRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error listing CloudFront Cache Policies: %w", err)
 		}
 
+		// AWS managed policies can be referenced without the "Managed-" name prefix.
+		if cachePolicyID == "" {
+			cachePolicyID = managedPolicyID
+		}
+
 		if cachePolicyID == "" {
 			return fmt.Errorf("no matching CloudFront Cache Policy (%s)", name)
 		}
@@ -64,6 +64,32 @@ func TestAccCloudFrontCachePolicyDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontCachePolicyDataSource_managedName(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_cache_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCachePolicyDataSourceManagedNameConfig("CachingOptimized"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-CachingOptimized"),
+				),
+			},
+			{
+				Config: testAccCachePolicyDataSourceManagedNameConfig("Managed-CachingOptimized"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-CachingOptimized"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCachePolicyDataSourceConfig(rName string) string {
 	return fmt.Sprintf(`
 data "aws_cloudfront_cache_policy" "by_name" {
@@ -109,3 +135,11 @@ resource "aws_cloudfront_cache_policy" "test" {
 }
 `, rName)
 }
+
+func testAccCachePolicyDataSourceManagedNameConfig(name string) string {
+	return fmt.Sprintf(`
+data "aws_cloudfront_cache_policy" "test" {
+  name = %[1]q
+}
+`, name)
+}
@@ -4,6 +4,10 @@ const (
 	StreamTypeKinesis = "Kinesis"
 )
 
+// managedPolicyNamePrefix is the prefix of the names of AWS managed cache, origin request and response headers policies,
+// e.g. "Managed-CachingOptimized".
+const managedPolicyNamePrefix = "Managed-"
+
 func StreamType_Values() []string {
 	return []string{
 		StreamTypeKinesis,
@@ -120,6 +120,7 @@ func dataSourceOriginRequestPolicyRead(d *schema.ResourceData, meta interface{})
 		originRequestPolicyID = v.(string)
 	} else {
 		name := d.Get("name").(string)
+		var managedPolicyID string
 		input := &cloudfront.ListOriginRequestPoliciesInput{}
 
 		err := ListOriginRequestPoliciesPages(conn, input, func(page *cloudfront.ListOriginRequestPoliciesOutput, lastPage bool) bool {
@@ -128,10 +129,15 @@ func dataSourceOriginRequestPolicyRead(d *schema.ResourceData, meta interface{})
 			}
 
 			for _, policySummary := range page.OriginRequestPolicyList.Items {
-				if originRequestPolicy := policySummary.OriginRequestPolicy; aws.StringValue(originRequestPolicy.OriginRequestPolicyConfig.Name) == name {
+				originRequestPolicy := policySummary.OriginRequestPolicy
+
+				switch policyName := aws.StringValue(originRequestPolicy.OriginRequestPolicyConfig.Name); {
+				case policyName == name:
 					originRequestPolicyID = aws.StringValue(originRequestPolicy.Id)
 
 					return false
+				case aws.StringValue(policySummary.Type) == cloudfront.OriginRequestPolicyTypeManaged && policyName == managedPolicyNamePrefix+name:
+					managedPolicyID = aws.StringValue(originRequestPolicy.Id)
 				}
 			}
 
@@ -142,6 +148,11 @@ func dataSourceOriginRequestPolicyRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("error listing CloudFront Origin Request Policies: %w", err)
 		}
 
+		// AWS managed policies can be referenced without the "Managed-" name prefix.
+		if originRequestPolicyID == "" {
+			originRequestPolicyID = managedPolicyID
+		}
+
 		if originRequestPolicyID == "" {
 			return fmt.Errorf("no matching CloudFront Origin Request Policy (%s)", name)
 		}
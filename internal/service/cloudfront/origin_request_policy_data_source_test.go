@@ -52,6 +52,32 @@ func TestAccCloudFrontOriginRequestPolicyDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontOriginRequestPolicyDataSource_managedName(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_origin_request_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginRequestPolicyDataSourceManagedNameConfig("AllViewer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-AllViewer"),
+				),
+			},
+			{
+				Config: testAccOriginRequestPolicyDataSourceManagedNameConfig("Managed-AllViewer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-AllViewer"),
+				),
+			},
+		},
+	})
+}
+
 func testAccOriginRequestPolicyDataSourceConfig(rName string) string {
 	return fmt.Sprintf(`
 data "aws_cloudfront_origin_request_policy" "by_name" {
@@ -92,3 +118,11 @@ resource "aws_cloudfront_origin_request_policy" "test" {
 }
 `, rName)
 }
+
+func testAccOriginRequestPolicyDataSourceManagedNameConfig(name string) string {
+	return fmt.Sprintf(`
+data "aws_cloudfront_origin_request_policy" "test" {
+  name = %[1]q
+}
+`, name)
+}
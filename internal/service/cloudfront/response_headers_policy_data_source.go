@@ -263,6 +263,7 @@ func dataSourceResponseHeadersPolicyRead(d *schema.ResourceData, meta interface{
 		responseHeadersPolicyID = v.(string)
 	} else {
 		name := d.Get("name").(string)
+		var managedPolicyID string
 		input := &cloudfront.ListResponseHeadersPoliciesInput{}
 
 		err := ListResponseHeadersPoliciesPages(conn, input, func(page *cloudfront.ListResponseHeadersPoliciesOutput, lastPage bool) bool {
@@ -271,10 +272,15 @@ func dataSourceResponseHeadersPolicyRead(d *schema.ResourceData, meta interface{
 			}
 
 			for _, policySummary := range page.ResponseHeadersPolicyList.Items {
-				if responseHeadersPolicy := policySummary.ResponseHeadersPolicy; aws.StringValue(responseHeadersPolicy.ResponseHeadersPolicyConfig.Name) == name {
+				responseHeadersPolicy := policySummary.ResponseHeadersPolicy
+
+				switch policyName := aws.StringValue(responseHeadersPolicy.ResponseHeadersPolicyConfig.Name); {
+				case policyName == name:
 					responseHeadersPolicyID = aws.StringValue(responseHeadersPolicy.Id)
 
 					return false
+				case aws.StringValue(policySummary.Type) == cloudfront.ResponseHeadersPolicyTypeManaged && policyName == managedPolicyNamePrefix+name:
+					managedPolicyID = aws.StringValue(responseHeadersPolicy.Id)
 				}
 			}
 
@@ -285,6 +291,11 @@ func dataSourceResponseHeadersPolicyRead(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("error listing CloudFront Response Headers Policies: %w", err)
 		}
 
+		// AWS managed policies can be referenced without the "Managed-" name prefix.
+		if responseHeadersPolicyID == "" {
+			responseHeadersPolicyID = managedPolicyID
+		}
+
 		if responseHeadersPolicyID == "" {
 			return fmt.Errorf("no matching CloudFront Response Headers Policy (%s)", name)
 		}
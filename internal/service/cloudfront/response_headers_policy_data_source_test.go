@@ -80,6 +80,32 @@ func TestAccCloudFrontResponseHeadersPolicyDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontResponseHeadersPolicyDataSource_managedName(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_response_headers_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponseHeadersPolicyDataSourceManagedNameConfig("SimpleCORS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-SimpleCORS"),
+				),
+			},
+			{
+				Config: testAccResponseHeadersPolicyDataSourceManagedNameConfig("Managed-SimpleCORS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-SimpleCORS"),
+				),
+			},
+		},
+	})
+}
+
 func testAccResponseHeadersPolicyDataSourceConfig(rName string) string {
 	return fmt.Sprintf(`
 data "aws_cloudfront_response_headers_policy" "by_name" {
@@ -146,3 +172,11 @@ resource "aws_cloudfront_response_headers_policy" "test" {
 }
 `, rName)
 }
+
+func testAccResponseHeadersPolicyDataSourceManagedNameConfig(name string) string {
+	return fmt.Sprintf(`
+data "aws_cloudfront_response_headers_policy" "test" {
+  name = %[1]q
+}
+`, name)
+}
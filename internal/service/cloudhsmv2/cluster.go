@@ -3,6 +3,7 @@ package cloudhsmv2
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -32,6 +33,27 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"backup_retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cloudhsmv2.BackupRetentionType_Values(), false),
+						},
+						"value": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(7, 379),
+						},
+					},
+				},
+			},
+
 			"source_backup_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -42,7 +64,7 @@ func ResourceCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"hsm1.medium"}, false),
+				ValidateFunc: validation.StringInSlice(ClusterHSMType_Values(), false),
 			},
 
 			"subnet_ids": {
@@ -124,6 +146,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		input.TagList = Tags(tags.IgnoreAWS())
 	}
 
+	if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BackupRetentionPolicy = expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("source_backup_identifier"); ok {
 		input.SourceBackupId = aws.String(v.(string))
 	}
@@ -186,6 +212,13 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Reading CloudHSMv2 Cluster Information: %s", d.Id())
 
+	if cluster.BackupRetentionPolicy != nil {
+		if err := d.Set("backup_retention_policy", []interface{}{flattenBackupRetentionPolicy(cluster.BackupRetentionPolicy)}); err != nil {
+			return fmt.Errorf("error setting backup_retention_policy: %w", err)
+		}
+	} else {
+		d.Set("backup_retention_policy", nil)
+	}
 	d.Set("cluster_id", cluster.ClusterId)
 	d.Set("cluster_state", cluster.State)
 	d.Set("security_group_id", cluster.SecurityGroup)
@@ -221,6 +254,20 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
 
+	if d.HasChange("backup_retention_policy") {
+		if v, ok := d.GetOk("backup_retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &cloudhsmv2.ModifyClusterInput{
+				BackupRetentionPolicy: expandBackupRetentionPolicy(v.([]interface{})[0].(map[string]interface{})),
+				ClusterId:             aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Modifying CloudHSMv2 Cluster: %s", input)
+			if _, err := conn.ModifyCluster(input); err != nil {
+				return fmt.Errorf("error modifying CloudHSMv2 Cluster (%s): %w", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
@@ -267,3 +314,41 @@ func readCloudHsmV2ClusterCertificates(cluster *cloudhsmv2.Cluster) []map[string
 	}
 	return []map[string]interface{}{}
 }
+
+func expandBackupRetentionPolicy(tfMap map[string]interface{}) *cloudhsmv2.BackupRetentionPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudhsmv2.BackupRetentionPolicy{}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(int); ok && v != 0 {
+		apiObject.Value = aws.String(strconv.Itoa(v))
+	}
+
+	return apiObject
+}
+
+func flattenBackupRetentionPolicy(apiObject *cloudhsmv2.BackupRetentionPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		if v, err := strconv.Atoi(aws.StringValue(v)); err == nil {
+			tfMap["value"] = v
+		}
+	}
+
+	return tfMap
+}
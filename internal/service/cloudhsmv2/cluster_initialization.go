@@ -0,0 +1,107 @@
+package cloudhsmv2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceClusterInitialization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceClusterInitializationCreate,
+		Read:   resourceClusterInitializationRead,
+		Delete: resourceClusterInitializationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"cluster_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"signed_cert": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"trust_anchor": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceClusterInitializationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
+
+	clusterID := d.Get("cluster_id").(string)
+	input := &cloudhsmv2.InitializeClusterInput{
+		ClusterId:   aws.String(clusterID),
+		SignedCert:  aws.String(d.Get("signed_cert").(string)),
+		TrustAnchor: aws.String(d.Get("trust_anchor").(string)),
+	}
+
+	log.Printf("[DEBUG] Initializing CloudHSMv2 Cluster: %s", clusterID)
+	_, err := conn.InitializeCluster(input)
+
+	if err != nil {
+		return fmt.Errorf("error initializing CloudHSMv2 Cluster (%s): %w", clusterID, err)
+	}
+
+	d.SetId(clusterID)
+
+	if _, err := waitClusterInitialized(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for CloudHSMv2 Cluster (%s) initialization: %w", d.Id(), err)
+	}
+
+	return resourceClusterInitializationRead(d, meta)
+}
+
+func resourceClusterInitializationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudHSMV2Conn
+
+	cluster, err := FindCluster(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudHSMv2 Cluster (%s): %w", d.Id(), err)
+	}
+
+	if !d.IsNewResource() && (cluster == nil || aws.StringValue(cluster.State) == cloudhsmv2.ClusterStateDeleted) {
+		log.Printf("[WARN] CloudHSMv2 Cluster (%s) not found, removing initialization from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if cluster == nil {
+		return fmt.Errorf("error reading CloudHSMv2 Cluster (%s): not found after initialization", d.Id())
+	}
+
+	d.Set("cluster_id", cluster.ClusterId)
+	d.Set("cluster_state", cluster.State)
+
+	return nil
+}
+
+func resourceClusterInitializationDelete(d *schema.ResourceData, meta interface{}) error {
+	// Initialization cannot be undone; removing the resource only removes it from state.
+	log.Printf("[WARN] CloudHSMv2 Cluster (%s) initialization cannot be undone, removing from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package cloudhsmv2
+
+const (
+	ClusterHSMTypeHSM1Medium  = "hsm1.medium"
+	ClusterHSMTypeHSM2MMedium = "hsm2m.medium"
+)
+
+func ClusterHSMType_Values() []string {
+	return []string{
+		ClusterHSMTypeHSM1Medium,
+		ClusterHSMTypeHSM2MMedium,
+	}
+}
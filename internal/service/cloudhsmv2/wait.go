@@ -107,3 +107,22 @@ func waitHSMDeleted(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duratio
 
 	return nil, err
 }
+
+func waitClusterInitialized(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{cloudhsmv2.ClusterStateInitializeInProgress},
+		Target:     []string{cloudhsmv2.ClusterStateInitialized, cloudhsmv2.ClusterStateActive},
+		Refresh:    statusClusterState(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*cloudhsmv2.Cluster); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -43,6 +43,8 @@ func init() {
 		},
 	})
 
+	sweep.RegisterNamePrefixSweepResource("logs:log-group", ResourceGroup(), sweep.ARNResourceName)
+
 	resource.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
 		Name: "aws_cloudwatch_query_definition",
 		F:    sweeplogQueryDefinitions,
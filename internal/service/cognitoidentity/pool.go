@@ -1,6 +1,7 @@
 package cognitoidentity
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -65,7 +67,6 @@ func ResourcePool() *schema.Resource {
 			"developer_provider_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true, // Forcing a new resource since it cannot be edited afterwards
 				ValidateFunc: validProviderDeveloperName,
 			},
 
@@ -112,7 +113,13 @@ func ResourcePool() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			// A developer provider name can be added to an existing identity pool but cannot be changed or removed once set.
+			customdiff.ForceNewIfChange("developer_provider_name", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(string) != ""
+			}),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -233,6 +240,10 @@ func resourcePoolUpdate(d *schema.ResourceData, meta interface{}) error {
 		IdentityPoolName:               aws.String(d.Get("identity_pool_name").(string)),
 	}
 
+	if v, ok := d.GetOk("developer_provider_name"); ok {
+		params.DeveloperProviderName = aws.String(v.(string))
+	}
+
 	if d.HasChanges(
 		"cognito_identity_providers",
 		"supported_login_providers",
@@ -173,12 +173,14 @@ func resourcePoolProviderPrincipalTagDelete(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+// DecodePoolProviderPrincipalTagsID splits a resource ID of the form
+// IdentityPoolID:ProviderName. Identity pool IDs are always of the form
+// region:GUID, so only the first two colons delimit the pool ID; provider names
+// such as OpenID Connect or SAML provider ARNs may themselves contain colons.
 func DecodePoolProviderPrincipalTagsID(id string) (string, string, error) {
-	idParts := strings.Split(id, ":")
-	if len(idParts) <= 2 {
-		return "", "", fmt.Errorf("expected ID in format UserPoolID:ProviderName, received: %s", id)
+	idParts := strings.SplitN(id, ":", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return "", "", fmt.Errorf("expected ID in format IdentityPoolID:ProviderName, received: %s", id)
 	}
-	providerName := idParts[len(idParts)-1:]
-	userPoolId := idParts[:len(idParts)-1]
-	return strings.Join(userPoolId, ":"), strings.Join(providerName, ""), nil
+	return strings.Join(idParts[:2], ":"), idParts[2], nil
 }
@@ -16,6 +16,54 @@ import (
 	tfcognitoidentity "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidentity"
 )
 
+func TestDecodePoolProviderPrincipalTagsID(t *testing.T) {
+	testCases := []struct {
+		ID                   string
+		ExpectedPoolID       string
+		ExpectedProviderName string
+		ErrCount             int
+	}{
+		{
+			ID:                   "us-west-2:1234abcd-12ab-34cd-56ef-1234567890ab:cognito-idp.us-west-2.amazonaws.com/us-west-2_abcdefghi",
+			ExpectedPoolID:       "us-west-2:1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedProviderName: "cognito-idp.us-west-2.amazonaws.com/us-west-2_abcdefghi",
+		},
+		{
+			ID:                   "us-west-2:1234abcd-12ab-34cd-56ef-1234567890ab:arn:aws:iam::123456789012:oidc-provider/accounts.example.com", //lintignore:AWSAT003,AWSAT005
+			ExpectedPoolID:       "us-west-2:1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedProviderName: "arn:aws:iam::123456789012:oidc-provider/accounts.example.com", //lintignore:AWSAT005
+		},
+		{
+			ID:       "us-west-2:1234abcd-12ab-34cd-56ef-1234567890ab",
+			ErrCount: 1,
+		},
+		{
+			ID:       "us-west-2:1234abcd-12ab-34cd-56ef-1234567890ab:",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		poolID, providerName, err := tfcognitoidentity.DecodePoolProviderPrincipalTagsID(tc.ID)
+
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+
+		if poolID != tc.ExpectedPoolID {
+			t.Fatalf("expected %q to return pool ID %q, received: %q", tc.ID, tc.ExpectedPoolID, poolID)
+		}
+
+		if providerName != tc.ExpectedProviderName {
+			t.Fatalf("expected %q to return provider name %q, received: %q", tc.ID, tc.ExpectedProviderName, providerName)
+		}
+	}
+}
+
 func TestAccCognitoIdentityPoolProviderPrincipalTags_basic(t *testing.T) {
 	resourceName := "aws_cognito_identity_pool_provider_principal_tag.test"
 	name := sdkacctest.RandString(10)
@@ -37,6 +85,46 @@ func TestAccCognitoIdentityPoolProviderPrincipalTags_basic(t *testing.T) {
 		},
 	})
 }
+func TestAccCognitoIdentityPoolProviderPrincipalTags_multipleProviders(t *testing.T) {
+	userPoolResourceName := "aws_cognito_identity_pool_provider_principal_tag.test"
+	oidcResourceName := "aws_cognito_identity_pool_provider_principal_tag.oidc"
+	oidcProviderResourceName := "aws_iam_openid_connect_provider.test"
+	name := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPoolProviderPrincipalTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolProviderPrincipalTagsConfig_multipleProviders(name, "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolProviderPrincipalTagsExists(userPoolResourceName),
+					testAccCheckPoolProviderPrincipalTagsExists(oidcResourceName),
+					resource.TestCheckResourceAttr(userPoolResourceName, "principal_tags.test", "value1"),
+					resource.TestCheckResourceAttrPair(oidcResourceName, "identity_provider_name", oidcProviderResourceName, "arn"),
+					resource.TestCheckResourceAttr(oidcResourceName, "principal_tags.test", "value1"),
+				),
+			},
+			{
+				Config: testAccPoolProviderPrincipalTagsConfig_multipleProviders(name, "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolProviderPrincipalTagsExists(userPoolResourceName),
+					testAccCheckPoolProviderPrincipalTagsExists(oidcResourceName),
+					resource.TestCheckResourceAttr(userPoolResourceName, "principal_tags.test", "value2"),
+					resource.TestCheckResourceAttr(oidcResourceName, "principal_tags.test", "value2"),
+				),
+			},
+			{
+				ResourceName:      oidcResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCognitoIdentityPoolProviderPrincipalTags_updated(t *testing.T) {
 	resourceName := "aws_cognito_identity_pool_provider_principal_tag.test"
 	name := sdkacctest.RandString(10)
@@ -197,3 +285,55 @@ resource "aws_cognito_identity_pool_provider_principal_tag" "test" {
 }
 `)
 }
+
+func testAccPoolProviderPrincipalTagsConfig_multipleProviders(name, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name                     = %[1]q
+  auto_verified_attributes = ["email"]
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+  supported_identity_providers = compact([
+    "COGNITO",
+  ])
+}
+
+resource "aws_iam_openid_connect_provider" "test" {
+  url             = "https://accounts.testle.com/%[1]s"
+  client_id_list  = ["266362248691-re108qaeld573ia0l6clj2i5ac7r7291.apps.testleusercontent.com"]
+  thumbprint_list = []
+}
+
+resource "aws_cognito_identity_pool" "test" {
+  identity_pool_name               = %[1]q
+  allow_unauthenticated_identities = false
+  cognito_identity_providers {
+    client_id               = aws_cognito_user_pool_client.test.id
+    provider_name           = aws_cognito_user_pool.test.endpoint
+    server_side_token_check = false
+  }
+  openid_connect_provider_arns = [aws_iam_openid_connect_provider.test.arn]
+}
+
+resource "aws_cognito_identity_pool_provider_principal_tag" "test" {
+  identity_pool_id       = aws_cognito_identity_pool.test.id
+  identity_provider_name = aws_cognito_user_pool.test.endpoint
+  use_defaults           = false
+  principal_tags = {
+    test = %[2]q
+  }
+}
+
+resource "aws_cognito_identity_pool_provider_principal_tag" "oidc" {
+  identity_pool_id       = aws_cognito_identity_pool.test.id
+  identity_provider_name = aws_iam_openid_connect_provider.test.arn
+  use_defaults           = false
+  principal_tags = {
+    test = %[2]q
+  }
+}
+`, name, tagValue)
+}
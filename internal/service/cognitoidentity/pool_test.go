@@ -94,6 +94,37 @@ func TestAccCognitoIdentityPool_DeveloperProviderName(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIdentityPool_DeveloperProviderName_added(t *testing.T) {
+	var v1, v2 cognitoidentity.IdentityPool
+	name := sdkacctest.RandString(10)
+	developerProviderName := sdkacctest.RandString(10)
+	resourceName := "aws_cognito_identity_pool.main"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "developer_provider_name", ""),
+				),
+			},
+			{
+				Config: testAccPoolConfig_DeveloperProviderName(name, developerProviderName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolExists(resourceName, &v2),
+					testAccCheckAWSCognitoIdentityPoolNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "developer_provider_name", developerProviderName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCognitoIdentityPool_supportedLoginProviders(t *testing.T) {
 	var v1, v2, v3 cognitoidentity.IdentityPool
 	name := sdkacctest.RandString(10)
@@ -0,0 +1,74 @@
+package dax
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindParameterGroupByName(conn *dax.DAX, name string) (*dax.ParameterGroup, error) {
+	input := &dax.DescribeParameterGroupsInput{
+		ParameterGroupNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeParameterGroups(input)
+
+	if tfawserr.ErrCodeEquals(err, dax.ErrCodeParameterGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ParameterGroups) == 0 || output.ParameterGroups[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ParameterGroups); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ParameterGroups[0], nil
+}
+
+func FindParametersByParameterGroupName(conn *dax.DAX, name string) ([]*dax.Parameter, error) {
+	input := &dax.DescribeParametersInput{
+		ParameterGroupName: aws.String(name),
+	}
+	var output []*dax.Parameter
+
+	for {
+		page, err := conn.DescribeParameters(input)
+
+		if tfawserr.ErrCodeEquals(err, dax.ErrCodeParameterGroupNotFoundFault) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Parameters {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
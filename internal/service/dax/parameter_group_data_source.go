@@ -0,0 +1,75 @@
+package dax
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceParameterGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"parameters": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DAXConn
+
+	name := d.Get("name").(string)
+
+	pg, err := FindParameterGroupByName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading DAX Parameter Group (%s): %w", name, err)
+	}
+
+	params, err := FindParametersByParameterGroupName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading DAX Parameter Group (%s) parameters: %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(pg.ParameterGroupName))
+	desc := aws.StringValue(pg.Description)
+	// default description is " "
+	if desc == " " {
+		desc = ""
+	}
+	d.Set("description", desc)
+	d.Set("name", pg.ParameterGroupName)
+
+	if err := d.Set("parameters", flattenDAXParameterGroupParameters(params)); err != nil {
+		return fmt.Errorf("error setting parameters: %w", err)
+	}
+
+	return nil
+}
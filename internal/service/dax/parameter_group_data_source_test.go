@@ -0,0 +1,44 @@
+package dax_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dax"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccDAXParameterGroupDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dax_parameter_group.test"
+	dataSourceName := "data.aws_dax_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, dax.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDaxParameterGroupDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "parameters.*", map[string]string{
+						"name":  "query-ttl-millis",
+						"value": "100000",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDaxParameterGroupDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccDaxParameterGroupConfig_parameters(rName), `
+data "aws_dax_parameter_group" "test" {
+  name = aws_dax_parameter_group.test.name
+}
+`)
+}
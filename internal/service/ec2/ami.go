@@ -67,6 +67,12 @@ func ResourceAMI() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(ec2.BootModeValues_Values(), false),
 			},
+			"deprecation_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     verify.ValidUTCTimestamp,
+				DiffSuppressFunc: suppressEquivalentRoundedTime(time.RFC3339, time.Minute),
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -343,6 +349,12 @@ func resourceAMICreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if v, ok := d.GetOk("deprecation_time"); ok {
+		if err := enableImageDeprecation(client, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAMIRead(d, meta)
 }
 
@@ -424,6 +436,7 @@ func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("architecture", image.Architecture)
 	d.Set("boot_mode", image.BootMode)
+	d.Set("deprecation_time", image.DeprecationTime)
 	d.Set("description", image.Description)
 	d.Set("ena_support", image.EnaSupport)
 	d.Set("hypervisor", image.Hypervisor)
@@ -497,6 +510,18 @@ func resourceAMIUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("deprecation_time") {
+		if v := d.Get("deprecation_time").(string); v != "" {
+			if err := enableImageDeprecation(client, d.Id(), v); err != nil {
+				return err
+			}
+		} else {
+			if err := disableImageDeprecation(client, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceAMIRead(d, meta)
 }
 
@@ -547,6 +572,37 @@ func resourceAMIDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func enableImageDeprecation(conn *ec2.EC2, id string, deprecateAt string) error {
+	v, _ := time.Parse(time.RFC3339, deprecateAt)
+
+	input := &ec2.EnableImageDeprecationInput{
+		DeprecateAt: aws.Time(v),
+		ImageId:     aws.String(id),
+	}
+
+	log.Printf("[DEBUG] Enabling EC2 AMI deprecation: %s", input)
+	_, err := conn.EnableImageDeprecation(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling EC2 AMI (%s) deprecation: %w", id, err)
+	}
+
+	return nil
+}
+
+func disableImageDeprecation(conn *ec2.EC2, id string) error {
+	log.Printf("[DEBUG] Disabling EC2 AMI deprecation: %s", id)
+	_, err := conn.DisableImageDeprecation(&ec2.DisableImageDeprecationInput{
+		ImageId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error disabling EC2 AMI (%s) deprecation: %w", id, err)
+	}
+
+	return nil
+}
+
 func AMIStateRefreshFunc(client *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		emptyResp := &ec2.DescribeImagesOutput{}
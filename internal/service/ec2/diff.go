@@ -1,6 +1,8 @@
 package ec2
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -10,3 +12,22 @@ import (
 func suppressEqualCIDRBlockDiffs(k, old, new string, d *schema.ResourceData) bool {
 	return verify.CIDRBlocksEqual(old, new)
 }
+
+// suppressEquivalentRoundedTime provides custom difference suppression for timestamps
+// that are equal once rounded to the specified duration, e.g. values that AWS rounds
+// to the nearest minute.
+func suppressEquivalentRoundedTime(layout string, d time.Duration) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, _ *schema.ResourceData) bool {
+		oldTime, err := time.Parse(layout, old)
+		if err != nil {
+			return false
+		}
+
+		newTime, err := time.Parse(layout, new)
+		if err != nil {
+			return false
+		}
+
+		return oldTime.Round(d).Equal(newTime.Round(d))
+	}
+}
@@ -20,6 +20,7 @@ const (
 	ErrCodeInvalidAllocationIDNotFound                  = "InvalidAllocationID.NotFound"
 	ErrCodeInvalidAssociationIDNotFound                 = "InvalidAssociationID.NotFound"
 	ErrCodeInvalidAttachmentIDNotFound                  = "InvalidAttachmentID.NotFound"
+	ErrCodeInvalidCapacityReservationFleetIDNotFound    = "InvalidCapacityReservationFleetId.NotFound"
 	ErrCodeInvalidCarrierGatewayIDNotFound              = "InvalidCarrierGatewayID.NotFound"
 	ErrCodeInvalidClientVpnActiveAssociationNotFound    = "InvalidClientVpnActiveAssociationNotFound"
 	ErrCodeInvalidClientVpnAssociationIdNotFound        = "InvalidClientVpnAssociationIdNotFound"
@@ -28,6 +29,7 @@ const (
 	ErrCodeInvalidClientVpnRouteNotFound                = "InvalidClientVpnRouteNotFound"
 	ErrCodeInvalidCustomerGatewayIDNotFound             = "InvalidCustomerGatewayID.NotFound"
 	ErrCodeInvalidDhcpOptionIDNotFound                  = "InvalidDhcpOptionID.NotFound"
+	ErrCodeInvalidFleetIDNotFound                       = "InvalidFleetId.NotFound"
 	ErrCodeInvalidFlowLogIdNotFound                     = "InvalidFlowLogId.NotFound"
 	ErrCodeInvalidGatewayIDNotFound                     = "InvalidGatewayID.NotFound"
 	ErrCodeInvalidGroupNotFound                         = "InvalidGroup.NotFound"
@@ -37,6 +39,8 @@ const (
 	ErrCodeInvalidKeyPairNotFound                       = "InvalidKeyPair.NotFound"
 	ErrCodeInvalidNetworkAclEntryNotFound               = "InvalidNetworkAclEntry.NotFound"
 	ErrCodeInvalidNetworkAclIDNotFound                  = "InvalidNetworkAclID.NotFound"
+	ErrCodeInvalidNetworkInsightsAnalysisIDNotFound     = "InvalidNetworkInsightsAnalysisId.NotFound"
+	ErrCodeInvalidNetworkInsightsPathIDNotFound         = "InvalidNetworkInsightsPathId.NotFound"
 	ErrCodeInvalidNetworkInterfaceIDNotFound            = "InvalidNetworkInterfaceID.NotFound"
 	ErrCodeInvalidParameter                             = "InvalidParameter"
 	ErrCodeInvalidParameterException                    = "InvalidParameterException"
@@ -51,6 +55,7 @@ const (
 	ErrCodeInvalidRouteTableIDNotFound                  = "InvalidRouteTableID.NotFound"
 	ErrCodeInvalidRouteTableIdNotFound                  = "InvalidRouteTableId.NotFound"
 	ErrCodeInvalidSecurityGroupIDNotFound               = "InvalidSecurityGroupID.NotFound"
+	ErrCodeInvalidSecurityGroupRuleIdNotFound           = "InvalidSecurityGroupRuleId.NotFound"
 	ErrCodeInvalidSnapshotInUse                         = "InvalidSnapshot.InUse"
 	ErrCodeInvalidSnapshotNotFound                      = "InvalidSnapshot.NotFound"
 	ErrCodeInvalidSpotDatafeedNotFound                  = "InvalidSpotDatafeed.NotFound"
@@ -59,6 +64,7 @@ const (
 	ErrCodeInvalidSubnetIDNotFound                      = "InvalidSubnetID.NotFound"
 	ErrCodeInvalidSubnetIdNotFound                      = "InvalidSubnetId.NotFound"
 	ErrCodeInvalidTransitGatewayAttachmentIDNotFound    = "InvalidTransitGatewayAttachmentID.NotFound"
+	ErrCodeInvalidTransitGatewayConnectPeerIDNotFound   = "InvalidTransitGatewayConnectPeerID.NotFound"
 	ErrCodeInvalidTransitGatewayIDNotFound              = "InvalidTransitGatewayID.NotFound"
 	ErrCodeInvalidVolumeNotFound                        = "InvalidVolume.NotFound"
 	ErrCodeInvalidVpcCidrBlockAssociationIDNotFound     = "InvalidVpcCidrBlockAssociationID.NotFound"
@@ -35,6 +35,10 @@ func ResourceFleet() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"context": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"excess_capacity_termination_policy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -44,6 +48,27 @@ func ResourceFleet() *schema.Resource {
 					ec2.FleetExcessCapacityTerminationPolicyTermination,
 				}, false),
 			},
+			"fleet_instance_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"launch_template_config": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -208,12 +233,16 @@ func ResourceFleet() *schema.Resource {
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"replacement_strategy": {
-													Type:     schema.TypeString,
-													Optional: true,
-													ForceNew: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														"launch",
-													}, false),
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(ec2.FleetReplacementStrategy_Values(), false),
+												},
+												"termination_delay": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntBetween(120, 7200),
 												},
 											},
 										},
@@ -346,6 +375,10 @@ func resourceFleetCreate(d *schema.ResourceData, meta interface{}) error {
 		Type:                             aws.String(d.Get("type").(string)),
 	}
 
+	if v, ok := d.GetOk("context"); ok {
+		input.Context = aws.String(v.(string))
+	}
+
 	if d.Get("type").(string) != ec2.FleetTypeMaintain {
 		if input.SpotOptions.MaintenanceStrategies != nil {
 			log.Printf("[WARN] EC2 Fleet (%s) has an invalid configuration and can not be created. Capacity Rebalance maintenance strategies can only be specified for fleets of type maintain.", input)
@@ -452,8 +485,19 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	d.Set("context", fleet.Context)
 	d.Set("excess_capacity_termination_policy", fleet.ExcessCapacityTerminationPolicy)
 
+	instances, err := FindFleetInstances(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Fleet (%s) instances: %w", d.Id(), err)
+	}
+
+	if err := d.Set("fleet_instance_set", flattenFleetActiveInstances(instances)); err != nil {
+		return fmt.Errorf("error setting fleet_instance_set: %w", err)
+	}
+
 	if err := d.Set("launch_template_config", flattenEc2FleetLaunchTemplateConfigs(fleet.LaunchTemplateConfigs)); err != nil {
 		return fmt.Errorf("error setting launch_template_config: %s", err)
 	}
@@ -503,6 +547,10 @@ func resourceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
 		},
 	}
 
+	if d.HasChange("context") {
+		input.Context = aws.String(d.Get("context").(string))
+	}
+
 	log.Printf("[DEBUG] Modifying EC2 Fleet (%s): %s", d.Id(), input)
 	_, err := conn.ModifyFleet(input)
 
@@ -777,6 +825,10 @@ func expandFleetSpotCapacityRebalanceRequest(l []interface{}) *ec2.FleetSpotCapa
 		capacityRebalance.ReplacementStrategy = aws.String(v.(string))
 	}
 
+	if v, ok := m["termination_delay"]; ok && v.(int) != 0 {
+		capacityRebalance.TerminationDelay = aws.Int64(int64(v.(int)))
+	}
+
 	return capacityRebalance
 }
 
@@ -912,6 +964,7 @@ func flattenFleetSpotCapacityRebalance(fleetSpotCapacityRebalance *ec2.FleetSpot
 
 	m := map[string]interface{}{
 		"replacement_strategy": aws.StringValue(fleetSpotCapacityRebalance.ReplacementStrategy),
+		"termination_delay":    aws.Int64Value(fleetSpotCapacityRebalance.TerminationDelay),
 	}
 
 	return []interface{}{m}
@@ -931,3 +984,49 @@ func flattenEc2TargetCapacitySpecification(targetCapacitySpecification *ec2.Targ
 
 	return []interface{}{m}
 }
+
+// flattenFleetActiveInstances groups a fleet's running instances by instance type and lifecycle.
+func flattenFleetActiveInstances(apiObjects []*ec2.ActiveInstance) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	type key struct {
+		instanceType string
+		lifecycle    string
+	}
+
+	var keys []key
+	instanceIDs := make(map[key][]string)
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		lifecycle := ec2.InstanceLifecycleOnDemand
+		if aws.StringValue(apiObject.SpotInstanceRequestId) != "" {
+			lifecycle = ec2.InstanceLifecycleSpot
+		}
+
+		k := key{instanceType: aws.StringValue(apiObject.InstanceType), lifecycle: lifecycle}
+
+		if _, ok := instanceIDs[k]; !ok {
+			keys = append(keys, k)
+		}
+
+		instanceIDs[k] = append(instanceIDs[k], aws.StringValue(apiObject.InstanceId))
+	}
+
+	var tfList []interface{}
+
+	for _, k := range keys {
+		tfList = append(tfList, map[string]interface{}{
+			"instance_ids":  instanceIDs[k],
+			"instance_type": k.instanceType,
+			"lifecycle":     k.lifecycle,
+		})
+	}
+
+	return tfList
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -433,3 +434,101 @@ func TestFlattenSecurityGroups(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandInt64Range(t *testing.T) {
+	rangeType := cty.Object(map[string]cty.Type{
+		"max": cty.Number,
+		"min": cty.Number,
+	})
+
+	cases := []struct {
+		name        string
+		tfMap       map[string]interface{}
+		rawConfig   cty.Value
+		expectedMin *int64
+		expectedMax *int64
+	}{
+		{
+			name:      "unset",
+			tfMap:     map[string]interface{}{"max": 0, "min": 0},
+			rawConfig: cty.ObjectVal(map[string]cty.Value{"max": cty.NullVal(cty.Number), "min": cty.NullVal(cty.Number)}),
+		},
+		{
+			name:        "explicit zero max",
+			tfMap:       map[string]interface{}{"max": 0, "min": 0},
+			rawConfig:   cty.ObjectVal(map[string]cty.Value{"max": cty.NumberIntVal(0), "min": cty.NullVal(cty.Number)}),
+			expectedMax: aws.Int64(0),
+		},
+		{
+			name:        "explicit zero min and max",
+			tfMap:       map[string]interface{}{"max": 0, "min": 0},
+			rawConfig:   cty.ObjectVal(map[string]cty.Value{"max": cty.NumberIntVal(0), "min": cty.NumberIntVal(0)}),
+			expectedMin: aws.Int64(0),
+			expectedMax: aws.Int64(0),
+		},
+		{
+			name:        "non-zero",
+			tfMap:       map[string]interface{}{"max": 4, "min": 1},
+			rawConfig:   cty.ObjectVal(map[string]cty.Value{"max": cty.NumberIntVal(4), "min": cty.NumberIntVal(1)}),
+			expectedMin: aws.Int64(1),
+			expectedMax: aws.Int64(4),
+		},
+		{
+			name:      "null raw config",
+			tfMap:     map[string]interface{}{"max": 0, "min": 0},
+			rawConfig: cty.NullVal(rangeType),
+		},
+		{
+			name:        "null raw config non-zero",
+			tfMap:       map[string]interface{}{"max": 2, "min": 0},
+			rawConfig:   cty.NullVal(cty.DynamicPseudoType),
+			expectedMax: aws.Int64(2),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			min, max := expandInt64Range(c.tfMap, c.rawConfig)
+
+			if !reflect.DeepEqual(min, c.expectedMin) {
+				t.Errorf("min: expected %v, got %v", aws.Int64Value(c.expectedMin), aws.Int64Value(min))
+			}
+
+			if !reflect.DeepEqual(max, c.expectedMax) {
+				t.Errorf("max: expected %v, got %v", aws.Int64Value(c.expectedMax), aws.Int64Value(max))
+			}
+		})
+	}
+}
+
+func TestRawConfigBlock(t *testing.T) {
+	rangeType := cty.Object(map[string]cty.Type{
+		"max": cty.Number,
+		"min": cty.Number,
+	})
+	configType := cty.Object(map[string]cty.Type{
+		"accelerator_count": cty.List(rangeType),
+	})
+
+	block := cty.ObjectVal(map[string]cty.Value{"max": cty.NumberIntVal(0), "min": cty.NullVal(cty.Number)})
+
+	if got := rawConfigBlock(cty.ObjectVal(map[string]cty.Value{"accelerator_count": cty.ListVal([]cty.Value{block})}), "accelerator_count"); !got.RawEquals(block) {
+		t.Errorf("expected %#v, got %#v", block, got)
+	}
+
+	if got := rawConfigBlock(cty.ObjectVal(map[string]cty.Value{"accelerator_count": cty.ListValEmpty(rangeType)}), "accelerator_count"); !got.IsNull() {
+		t.Errorf("expected null for empty block list, got %#v", got)
+	}
+
+	if got := rawConfigBlock(cty.NullVal(configType), "accelerator_count"); !got.IsNull() {
+		t.Errorf("expected null for null configuration, got %#v", got)
+	}
+
+	if got := rawConfigBlock(cty.UnknownVal(configType), "accelerator_count"); !got.IsNull() {
+		t.Errorf("expected null for unknown configuration, got %#v", got)
+	}
+
+	if got := rawConfigBlock(cty.ObjectVal(map[string]cty.Value{"accelerator_count": cty.ListVal([]cty.Value{block})}), "vcpu_count"); !got.IsNull() {
+		t.Errorf("expected null for missing attribute, got %#v", got)
+	}
+}
@@ -0,0 +1,164 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceInstanceState() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceStateCreate,
+		Read:   resourceInstanceStateRead,
+		Update: resourceInstanceStateUpdate,
+		Delete: resourceInstanceStateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.InstanceStateNameRunning,
+					ec2.InstanceStateNameStopped,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceInstanceStateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID := d.Get("instance_id").(string)
+
+	instance, err := WaitInstanceReady(conn, instanceID, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 Instance (%s) to be ready: %w", instanceID, err)
+	}
+
+	if err := updateInstanceState(conn, instanceID, aws.StringValue(instance.State.Name), d.Get("state").(string), d.Get("force").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(instanceID)
+
+	return resourceInstanceStateRead(d, meta)
+}
+
+func resourceInstanceStateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instance, err := FindInstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance (%s): %w", d.Id(), err)
+	}
+
+	d.Set("instance_id", instance.InstanceId)
+	d.Set("state", instance.State.Name)
+
+	return nil
+}
+
+func resourceInstanceStateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instance, err := WaitInstanceReady(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 Instance (%s) to be ready: %w", d.Id(), err)
+	}
+
+	if d.HasChange("state") {
+		o, n := d.GetChange("state")
+
+		log.Printf("[DEBUG] Updating EC2 Instance (%s) state from %s to %s", d.Id(), o, n)
+
+		if err := updateInstanceState(conn, d.Id(), aws.StringValue(instance.State.Name), n.(string), d.Get("force").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceInstanceStateRead(d, meta)
+}
+
+func resourceInstanceStateDelete(d *schema.ResourceData, meta interface{}) error {
+	// The instance is left in its current state.
+	log.Printf("[WARN] EC2 Instance (%s) state cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func updateInstanceState(conn *ec2.EC2, id string, currentState string, configuredState string, force bool, timeout time.Duration) error {
+	if currentState == configuredState {
+		return nil
+	}
+
+	if configuredState == ec2.InstanceStateNameStopped {
+		input := &ec2.StopInstancesInput{
+			Force:       aws.Bool(force),
+			InstanceIds: aws.StringSlice([]string{id}),
+		}
+
+		log.Printf("[DEBUG] Stopping EC2 Instance: %s", input)
+		if _, err := conn.StopInstances(input); err != nil {
+			return fmt.Errorf("error stopping EC2 Instance (%s): %w", id, err)
+		}
+
+		if _, err := WaitInstanceStopped(conn, id, timeout); err != nil {
+			return fmt.Errorf("error waiting for EC2 Instance (%s) stop: %w", id, err)
+		}
+
+		return nil
+	}
+
+	if configuredState == ec2.InstanceStateNameRunning {
+		input := &ec2.StartInstancesInput{
+			InstanceIds: aws.StringSlice([]string{id}),
+		}
+
+		log.Printf("[DEBUG] Starting EC2 Instance: %s", input)
+		if _, err := conn.StartInstances(input); err != nil {
+			return fmt.Errorf("error starting EC2 Instance (%s): %w", id, err)
+		}
+
+		if _, err := WaitInstanceStarted(conn, id, timeout); err != nil {
+			return fmt.Errorf("error waiting for EC2 Instance (%s) start: %w", id, err)
+		}
+	}
+
+	return nil
+}
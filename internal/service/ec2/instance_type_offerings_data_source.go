@@ -16,6 +16,12 @@ func DataSourceInstanceTypeOfferings() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"filter": DataSourceFiltersSchema(),
+			"instance_requirements_with_metadata": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     instanceRequirementsWithMetadataSchema(),
+			},
 			"instance_types": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -53,6 +59,32 @@ func dataSourceInstanceTypeOfferingsRead(d *schema.ResourceData, meta interface{
 		input.LocationType = aws.String(v.(string))
 	}
 
+	// Instance types matching the specified attributes, if any.
+	var matchingInstanceTypes map[string]struct{}
+
+	if v, ok := d.GetOk("instance_requirements_with_metadata"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject := expandInstanceRequirementsWithMetadataRequest(v.([]interface{})[0].(map[string]interface{}), rawConfigBlock(d.GetRawConfig(), "instance_requirements_with_metadata"))
+
+		if len(apiObject.ArchitectureTypes) == 0 || len(apiObject.VirtualizationTypes) == 0 {
+			return fmt.Errorf("instance_requirements_with_metadata: architecture_types and virtualization_types must be specified")
+		}
+
+		output, err := FindInstanceTypesFromInstanceRequirements(conn, &ec2.GetInstanceTypesFromInstanceRequirementsInput{
+			ArchitectureTypes:    apiObject.ArchitectureTypes,
+			InstanceRequirements: apiObject.InstanceRequirements,
+			VirtualizationTypes:  apiObject.VirtualizationTypes,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading EC2 Instance Types from instance requirements: %w", err)
+		}
+
+		matchingInstanceTypes = make(map[string]struct{}, len(output))
+		for _, v := range output {
+			matchingInstanceTypes[v] = struct{}{}
+		}
+	}
+
 	var instanceTypes []string
 	var locations []string
 	var locationTypes []string
@@ -67,7 +99,15 @@ func dataSourceInstanceTypeOfferingsRead(d *schema.ResourceData, meta interface{
 				continue
 			}
 
-			instanceTypes = append(instanceTypes, aws.StringValue(instanceTypeOffering.InstanceType))
+			instanceType := aws.StringValue(instanceTypeOffering.InstanceType)
+
+			if matchingInstanceTypes != nil {
+				if _, ok := matchingInstanceTypes[instanceType]; !ok {
+					continue
+				}
+			}
+
+			instanceTypes = append(instanceTypes, instanceType)
 			locations = append(locations, aws.StringValue(instanceTypeOffering.Location))
 			locationTypes = append(locationTypes, aws.StringValue(instanceTypeOffering.LocationType))
 		}
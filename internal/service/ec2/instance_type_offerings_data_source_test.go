@@ -51,6 +51,26 @@ func TestAccEC2InstanceTypeOfferingsDataSource_locationType(t *testing.T) {
 	})
 }
 
+func TestAccEC2InstanceTypeOfferingsDataSource_instanceRequirements(t *testing.T) {
+	dataSourceName := "data.aws_ec2_instance_type_offerings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstanceTypeOfferings(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceTypeOfferingsInstanceRequirementsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2InstanceTypeOfferingsInstanceTypes(dataSourceName),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "instance_types.*", "t3.micro"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEc2InstanceTypeOfferingsInstanceTypes(dataSourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[dataSourceName]
@@ -130,3 +150,33 @@ data "aws_ec2_instance_type_offerings" "test" {
 }
 `
 }
+
+func testAccInstanceTypeOfferingsInstanceRequirementsDataSourceConfig() string {
+	return `
+data "aws_ec2_instance_type_offerings" "test" {
+  filter {
+    name   = "instance-type"
+    values = ["t3.micro", "c5.large", "m5.large"]
+  }
+
+  instance_requirements_with_metadata {
+    architecture_types   = ["x86_64"]
+    virtualization_types = ["hvm"]
+
+    instance_requirements {
+      burstable_performance = "included"
+
+      memory_mib {
+        min = 1024
+        max = 1024
+      }
+
+      vcpu_count {
+        min = 2
+        max = 2
+      }
+    }
+  }
+}
+`
+}
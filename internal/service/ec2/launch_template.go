@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -347,9 +348,18 @@ func ResourceLaunchTemplate() *schema.Resource {
 				},
 			},
 
+			"instance_requirements": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"instance_type"},
+				Elem:          instanceRequirementsSchema(),
+			},
+
 			"instance_type": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"instance_requirements"},
 			},
 
 			"kernel_id": {
@@ -836,6 +846,10 @@ func resourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting instance_market_options: %s", err)
 	}
 
+	if err := d.Set("instance_requirements", flattenInstanceRequirements(ltData.InstanceRequirements)); err != nil {
+		return fmt.Errorf("error setting instance_requirements: %w", err)
+	}
+
 	if err := d.Set("license_specification", getLicenseSpecifications(ltData.LicenseSpecifications)); err != nil {
 		return fmt.Errorf("error setting license_specification: %s", err)
 	}
@@ -1442,6 +1456,10 @@ func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplate
 		}
 	}
 
+	if v, ok := d.GetOk("instance_requirements"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		opts.InstanceRequirements = expandInstanceRequirementsRequest(v.([]interface{})[0].(map[string]interface{}), rawConfigBlock(d.GetRawConfig(), "instance_requirements"))
+	}
+
 	if v, ok := d.GetOk("license_specification"); ok {
 		var licenseSpecifications []*ec2.LaunchTemplateLicenseConfigurationRequest
 		lsList := v.(*schema.Set).List()
@@ -1911,6 +1929,7 @@ var updateKeys = []string{
 	"image_id",
 	"instance_initiated_shutdown_behavior",
 	"instance_market_options",
+	"instance_requirements",
 	"instance_type",
 	"kernel_id",
 	"key_name",
@@ -1925,3 +1944,582 @@ var updateKeys = []string{
 	"user_data",
 	"vpc_security_group_ids",
 }
+
+func instanceRequirementsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"accelerator_count": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"accelerator_manufacturers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.AcceleratorManufacturer_Values(), false),
+				},
+			},
+			"accelerator_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.AcceleratorName_Values(), false),
+				},
+			},
+			"accelerator_total_memory_mib": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"accelerator_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.AcceleratorType_Values(), false),
+				},
+			},
+			"bare_metal": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.BareMetal_Values(), false),
+			},
+			"baseline_ebs_bandwidth_mbps": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"burstable_performance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.BurstablePerformance_Values(), false),
+			},
+			"cpu_manufacturers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.CpuManufacturer_Values(), false),
+				},
+			},
+			"excluded_instance_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 400,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 30),
+				},
+			},
+			"instance_generations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.InstanceGeneration_Values(), false),
+				},
+			},
+			"local_storage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.LocalStorage_Values(), false),
+			},
+			"local_storage_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.LocalStorageType_Values(), false),
+				},
+			},
+			"memory_gib_per_vcpu": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0.0),
+						},
+						"min": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0.0),
+						},
+					},
+				},
+			},
+			"memory_mib": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"network_interface_count": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"on_demand_max_price_percentage_over_lowest_price": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"require_hibernate_support": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"spot_max_price_percentage_over_lowest_price": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"total_local_storage_gb": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0.0),
+						},
+						"min": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0.0),
+						},
+					},
+				},
+			},
+			"vcpu_count": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func instanceRequirementsWithMetadataSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"architecture_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.ArchitectureType_Values(), false),
+				},
+			},
+			"instance_requirements": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     instanceRequirementsSchema(),
+			},
+			"virtualization_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.VirtualizationType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandInstanceRequirementsWithMetadataRequest(tfMap map[string]interface{}, rawConfig cty.Value) *ec2.InstanceRequirementsWithMetadataRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.InstanceRequirementsWithMetadataRequest{}
+
+	if v, ok := tfMap["architecture_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ArchitectureTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["instance_requirements"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InstanceRequirements = expandInstanceRequirementsRequest(v[0].(map[string]interface{}), rawConfigBlock(rawConfig, "instance_requirements"))
+	}
+
+	if v, ok := tfMap["virtualization_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VirtualizationTypes = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandInstanceRequirementsRequest(tfMap map[string]interface{}, rawConfig cty.Value) *ec2.InstanceRequirementsRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.InstanceRequirementsRequest{}
+
+	if v, ok := tfMap["accelerator_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInt64Range(v[0].(map[string]interface{}), rawConfigBlock(rawConfig, "accelerator_count"))
+		apiObject.AcceleratorCount = &ec2.AcceleratorCountRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["accelerator_manufacturers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AcceleratorManufacturers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["accelerator_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AcceleratorNames = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["accelerator_total_memory_mib"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInt64Range(v[0].(map[string]interface{}), rawConfigBlock(rawConfig, "accelerator_total_memory_mib"))
+		apiObject.AcceleratorTotalMemoryMiB = &ec2.AcceleratorTotalMemoryMiBRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["accelerator_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AcceleratorTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["bare_metal"].(string); ok && v != "" {
+		apiObject.BareMetal = aws.String(v)
+	}
+
+	if v, ok := tfMap["baseline_ebs_bandwidth_mbps"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInt64Range(v[0].(map[string]interface{}), rawConfigBlock(rawConfig, "baseline_ebs_bandwidth_mbps"))
+		apiObject.BaselineEbsBandwidthMbps = &ec2.BaselineEbsBandwidthMbpsRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["burstable_performance"].(string); ok && v != "" {
+		apiObject.BurstablePerformance = aws.String(v)
+	}
+
+	if v, ok := tfMap["cpu_manufacturers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CpuManufacturers = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["excluded_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExcludedInstanceTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["instance_generations"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InstanceGenerations = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["local_storage"].(string); ok && v != "" {
+		apiObject.LocalStorage = aws.String(v)
+	}
+
+	if v, ok := tfMap["local_storage_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LocalStorageTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["memory_gib_per_vcpu"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandFloat64Range(v[0].(map[string]interface{}), rawConfigBlock(rawConfig, "memory_gib_per_vcpu"))
+		apiObject.MemoryGiBPerVCpu = &ec2.MemoryGiBPerVCpuRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["memory_mib"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInt64Range(v[0].(map[string]interface{}), rawConfigBlock(rawConfig, "memory_mib"))
+		apiObject.MemoryMiB = &ec2.MemoryMiBRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["network_interface_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInt64Range(v[0].(map[string]interface{}), rawConfigBlock(rawConfig, "network_interface_count"))
+		apiObject.NetworkInterfaceCount = &ec2.NetworkInterfaceCountRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["on_demand_max_price_percentage_over_lowest_price"].(int); ok && v != 0 {
+		apiObject.OnDemandMaxPricePercentageOverLowestPrice = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["require_hibernate_support"].(bool); ok && v {
+		apiObject.RequireHibernateSupport = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["spot_max_price_percentage_over_lowest_price"].(int); ok && v != 0 {
+		apiObject.SpotMaxPricePercentageOverLowestPrice = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["total_local_storage_gb"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandFloat64Range(v[0].(map[string]interface{}), rawConfigBlock(rawConfig, "total_local_storage_gb"))
+		apiObject.TotalLocalStorageGB = &ec2.TotalLocalStorageGBRequest{Max: max, Min: min}
+	}
+
+	if v, ok := tfMap["vcpu_count"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		min, max := expandInt64Range(v[0].(map[string]interface{}), rawConfigBlock(rawConfig, "vcpu_count"))
+		apiObject.VCpuCount = &ec2.VCpuCountRangeRequest{Max: max, Min: min}
+	}
+
+	return apiObject
+}
+
+// expandInt64Range returns the "min" and "max" values of a range configuration block.
+// Zero values are treated as unset unless explicitly set in the block's raw configuration.
+func expandInt64Range(tfMap map[string]interface{}, rawConfig cty.Value) (*int64, *int64) {
+	var min, max *int64
+
+	if v, ok := tfMap["min"].(int); ok && (v != 0 || rawConfigAttributeSet(rawConfig, "min")) {
+		min = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max"].(int); ok && (v != 0 || rawConfigAttributeSet(rawConfig, "max")) {
+		max = aws.Int64(int64(v))
+	}
+
+	return min, max
+}
+
+// expandFloat64Range returns the "min" and "max" values of a range configuration block.
+// Zero values are treated as unset unless explicitly set in the block's raw configuration.
+func expandFloat64Range(tfMap map[string]interface{}, rawConfig cty.Value) (*float64, *float64) {
+	var min, max *float64
+
+	if v, ok := tfMap["min"].(float64); ok && (v != 0 || rawConfigAttributeSet(rawConfig, "min")) {
+		min = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["max"].(float64); ok && (v != 0 || rawConfigAttributeSet(rawConfig, "max")) {
+		max = aws.Float64(v)
+	}
+
+	return min, max
+}
+
+// rawConfigBlock returns the raw configuration of the first nested block with the given name,
+// or a null value if the block is not configured.
+func rawConfigBlock(rawConfig cty.Value, name string) cty.Value {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(name) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	v := rawConfig.GetAttr(name)
+
+	if !v.IsKnown() || v.IsNull() || !v.CanIterateElements() || v.LengthInt() == 0 {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	return v.Index(cty.NumberIntVal(0))
+}
+
+// rawConfigAttributeSet returns whether the attribute with the given name is set in a block's raw configuration.
+func rawConfigAttributeSet(rawConfig cty.Value, name string) bool {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(name) {
+		return false
+	}
+
+	v := rawConfig.GetAttr(name)
+
+	return v.IsKnown() && !v.IsNull()
+}
+
+func flattenInstanceRequirements(apiObject *ec2.InstanceRequirements) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AcceleratorCount; v != nil {
+		tfMap["accelerator_count"] = flattenInt64Range(v.Min, v.Max)
+	}
+
+	if v := apiObject.AcceleratorManufacturers; v != nil {
+		tfMap["accelerator_manufacturers"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.AcceleratorNames; v != nil {
+		tfMap["accelerator_names"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.AcceleratorTotalMemoryMiB; v != nil {
+		tfMap["accelerator_total_memory_mib"] = flattenInt64Range(v.Min, v.Max)
+	}
+
+	if v := apiObject.AcceleratorTypes; v != nil {
+		tfMap["accelerator_types"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.BareMetal; v != nil {
+		tfMap["bare_metal"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.BaselineEbsBandwidthMbps; v != nil {
+		tfMap["baseline_ebs_bandwidth_mbps"] = flattenInt64Range(v.Min, v.Max)
+	}
+
+	if v := apiObject.BurstablePerformance; v != nil {
+		tfMap["burstable_performance"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.CpuManufacturers; v != nil {
+		tfMap["cpu_manufacturers"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ExcludedInstanceTypes; v != nil {
+		tfMap["excluded_instance_types"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.InstanceGenerations; v != nil {
+		tfMap["instance_generations"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.LocalStorage; v != nil {
+		tfMap["local_storage"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LocalStorageTypes; v != nil {
+		tfMap["local_storage_types"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.MemoryGiBPerVCpu; v != nil {
+		tfMap["memory_gib_per_vcpu"] = flattenFloat64Range(v.Min, v.Max)
+	}
+
+	if v := apiObject.MemoryMiB; v != nil {
+		tfMap["memory_mib"] = flattenInt64Range(v.Min, v.Max)
+	}
+
+	if v := apiObject.NetworkInterfaceCount; v != nil {
+		tfMap["network_interface_count"] = flattenInt64Range(v.Min, v.Max)
+	}
+
+	if v := apiObject.OnDemandMaxPricePercentageOverLowestPrice; v != nil {
+		tfMap["on_demand_max_price_percentage_over_lowest_price"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.RequireHibernateSupport; v != nil {
+		tfMap["require_hibernate_support"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.SpotMaxPricePercentageOverLowestPrice; v != nil {
+		tfMap["spot_max_price_percentage_over_lowest_price"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.TotalLocalStorageGB; v != nil {
+		tfMap["total_local_storage_gb"] = flattenFloat64Range(v.Min, v.Max)
+	}
+
+	if v := apiObject.VCpuCount; v != nil {
+		tfMap["vcpu_count"] = flattenInt64Range(v.Min, v.Max)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInt64Range(min, max *int64) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	if min != nil {
+		tfMap["min"] = aws.Int64Value(min)
+	}
+
+	if max != nil {
+		tfMap["max"] = aws.Int64Value(max)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFloat64Range(min, max *float64) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	if min != nil {
+		tfMap["min"] = aws.Float64Value(min)
+	}
+
+	if max != nil {
+		tfMap["max"] = aws.Float64Value(max)
+	}
+
+	return []interface{}{tfMap}
+}
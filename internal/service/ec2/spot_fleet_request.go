@@ -499,6 +499,12 @@ func ResourceSpotFleetRequest() *schema.Resource {
 										ForceNew:     true,
 										ValidateFunc: validation.StringInSlice(ec2.ReplacementStrategy_Values(), false),
 									},
+									"termination_delay": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(120, 7200),
+									},
 								},
 							},
 						},
@@ -923,6 +929,10 @@ func expandSpotCapacityRebalance(l []interface{}) *ec2.SpotCapacityRebalance {
 		capacityRebalance.ReplacementStrategy = aws.String(v.(string))
 	}
 
+	if v, ok := m["termination_delay"]; ok && v.(int) != 0 {
+		capacityRebalance.TerminationDelay = aws.Int64(int64(v.(int)))
+	}
+
 	return capacityRebalance
 }
 
@@ -1863,6 +1873,7 @@ func flattenSpotCapacityRebalance(spotCapacityRebalance *ec2.SpotCapacityRebalan
 
 	m := map[string]interface{}{
 		"replacement_strategy": aws.StringValue(spotCapacityRebalance.ReplacementStrategy),
+		"termination_delay":    aws.Int64Value(spotCapacityRebalance.TerminationDelay),
 	}
 
 	return []interface{}{m}
@@ -0,0 +1,88 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2SpotPlacementScoresDataSource_instanceTypes(t *testing.T) {
+	dataSourceName := "data.aws_ec2_spot_placement_scores.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoresInstanceTypesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.region"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.score"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotPlacementScoresDataSource_instanceRequirements(t *testing.T) {
+	dataSourceName := "data.aws_ec2_spot_placement_scores.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoresInstanceRequirementsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.availability_zone_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "spot_placement_scores.0.region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.score"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpotPlacementScoresInstanceTypesDataSourceConfig() string {
+	return `
+data "aws_ec2_spot_placement_scores" "test" {
+  instance_types  = ["t3.micro", "t3.small", "m5.large"]
+  target_capacity = 2
+}
+`
+}
+
+func testAccSpotPlacementScoresInstanceRequirementsDataSourceConfig() string {
+	return `
+data "aws_region" "current" {}
+
+data "aws_ec2_spot_placement_scores" "test" {
+  region_names              = [data.aws_region.current.name]
+  single_availability_zone  = true
+  target_capacity           = 4
+  target_capacity_unit_type = "vcpu"
+
+  instance_requirements_with_metadata {
+    architecture_types   = ["x86_64"]
+    virtualization_types = ["hvm"]
+
+    instance_requirements {
+      memory_mib {
+        min = 2048
+      }
+
+      vcpu_count {
+        min = 2
+        max = 4
+      }
+    }
+  }
+}
+`
+}
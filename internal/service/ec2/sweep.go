@@ -33,6 +33,11 @@ func init() {
 		F:    sweepCapacityReservations,
 	})
 
+	resource.AddTestSweepers("aws_ec2_capacity_reservation_fleet", &resource.Sweeper{
+		Name: "aws_ec2_capacity_reservation_fleet",
+		F:    sweepCapacityReservationFleets,
+	})
+
 	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateway,
@@ -134,6 +139,19 @@ func init() {
 		F:    sweepNATGateways,
 	})
 
+	resource.AddTestSweepers("aws_ec2_network_insights_analysis", &resource.Sweeper{
+		Name: "aws_ec2_network_insights_analysis",
+		F:    sweepNetworkInsightsAnalyses,
+	})
+
+	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+		Name: "aws_ec2_network_insights_path",
+		F:    sweepNetworkInsightsPaths,
+		Dependencies: []string{
+			"aws_ec2_network_insights_analysis",
+		},
+	})
+
 	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
 		Name: "aws_network_acl",
 		F:    sweepNetworkACLs,
@@ -219,6 +237,19 @@ func init() {
 		},
 	})
 
+	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+		Name: "aws_ec2_transit_gateway_connect",
+		F:    sweepTransitGatewayConnects,
+		Dependencies: []string{
+			"aws_ec2_transit_gateway_connect_peer",
+		},
+	})
+
+	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+		Name: "aws_ec2_transit_gateway_connect_peer",
+		F:    sweepTransitGatewayConnectPeers,
+	})
+
 	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
@@ -229,6 +260,7 @@ func init() {
 		F:    sweepTransitGateways,
 		Dependencies: []string{
 			"aws_dx_gateway_association",
+			"aws_ec2_transit_gateway_connect",
 			"aws_ec2_transit_gateway_vpc_attachment",
 			"aws_ec2_transit_gateway_peering_attachment",
 			"aws_vpn_connection",
@@ -238,6 +270,9 @@ func init() {
 	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
+		Dependencies: []string{
+			"aws_ec2_transit_gateway_connect",
+		},
 	})
 
 	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
@@ -335,6 +370,54 @@ func init() {
 	})
 }
 
+func sweepCapacityReservationFleets(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EC2Conn
+	input := &ec2.DescribeCapacityReservationFleetsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.DescribeCapacityReservationFleetsPages(input, func(page *ec2.DescribeCapacityReservationFleetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CapacityReservationFleets {
+			switch aws.StringValue(v.State) {
+			case ec2.CapacityReservationFleetStateCancelled, ec2.CapacityReservationFleetStateCancelling, ec2.CapacityReservationFleetStateExpired:
+				continue
+			}
+
+			r := ResourceCapacityReservationFleet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.CapacityReservationFleetId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Capacity Reservation Fleet sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EC2 Capacity Reservation Fleets (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Capacity Reservation Fleets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepCapacityReservations(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
@@ -1125,6 +1208,92 @@ func sweepNetworkACLs(region string) error {
 	return nil
 }
 
+func sweepNetworkInsightsAnalyses(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EC2Conn
+	input := &ec2.DescribeNetworkInsightsAnalysesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.DescribeNetworkInsightsAnalysesPages(input, func(page *ec2.DescribeNetworkInsightsAnalysesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.NetworkInsightsAnalyses {
+			r := ResourceNetworkInsightsAnalysis()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.NetworkInsightsAnalysisId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Network Insights Analysis sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EC2 Network Insights Analyses (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Network Insights Analyses (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepNetworkInsightsPaths(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EC2Conn
+	input := &ec2.DescribeNetworkInsightsPathsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.DescribeNetworkInsightsPathsPages(input, func(page *ec2.DescribeNetworkInsightsPathsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.NetworkInsightsPaths {
+			r := ResourceNetworkInsightsPath()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.NetworkInsightsPathId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Network Insights Path sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EC2 Network Insights Paths (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Network Insights Paths (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepNetworkInterfaces(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
@@ -1655,6 +1824,100 @@ func sweepTransitGateways(region string) error {
 	return nil
 }
 
+func sweepTransitGatewayConnects(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EC2Conn
+	input := &ec2.DescribeTransitGatewayConnectsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.DescribeTransitGatewayConnectsPages(input, func(page *ec2.DescribeTransitGatewayConnectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TransitGatewayConnects {
+			if aws.StringValue(v.State) == ec2.TransitGatewayAttachmentStateDeleted {
+				continue
+			}
+
+			r := ResourceTransitGatewayConnect()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.TransitGatewayAttachmentId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Transit Gateway Connect sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EC2 Transit Gateway Connects (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Transit Gateway Connects (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepTransitGatewayConnectPeers(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EC2Conn
+	input := &ec2.DescribeTransitGatewayConnectPeersInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.DescribeTransitGatewayConnectPeersPages(input, func(page *ec2.DescribeTransitGatewayConnectPeersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TransitGatewayConnectPeers {
+			if aws.StringValue(v.State) == ec2.TransitGatewayConnectPeerStateDeleted {
+				continue
+			}
+
+			r := ResourceTransitGatewayConnectPeer()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.TransitGatewayConnectPeerId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Transit Gateway Connect Peer sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EC2 Transit Gateway Connect Peers (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Transit Gateway Connect Peers (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepTransitGatewayVPCAttachments(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -107,6 +108,18 @@ func ResourceTransitGateway() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"transit_gateway_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.Any(
+						validation.All(verify.ValidIPv4CIDRNetworkAddress, validation.IsCIDRNetwork(16, 24)),
+						validation.All(verify.ValidIPv6CIDRNetworkAddress, validation.IsCIDRNetwork(40, 64)),
+					),
+				},
+			},
 			"vpn_ecmp_support": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -144,6 +157,10 @@ func resourceTransitGatewayCreate(d *schema.ResourceData, meta interface{}) erro
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("transit_gateway_cidr_blocks"); ok && v.(*schema.Set).Len() > 0 {
+		input.Options.TransitGatewayCidrBlocks = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	log.Printf("[DEBUG] Creating EC2 Transit Gateway: %s", input)
 	output, err := conn.CreateTransitGateway(input)
 	if err != nil {
@@ -214,6 +231,7 @@ func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting tags_all: %w", err)
 	}
 
+	d.Set("transit_gateway_cidr_blocks", aws.StringValueSlice(transitGateway.Options.TransitGatewayCidrBlocks))
 	d.Set("vpn_ecmp_support", transitGateway.Options.VpnEcmpSupport)
 
 	return nil
@@ -252,6 +270,21 @@ func resourceTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 		options.DnsSupport = aws.String(d.Get("dns_support").(string))
 	}
 
+	if d.HasChange("transit_gateway_cidr_blocks") {
+		transitGatewayModified = true
+
+		o, n := d.GetChange("transit_gateway_cidr_blocks")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if v := ns.Difference(os); v.Len() > 0 {
+			options.AddTransitGatewayCidrBlocks = flex.ExpandStringSet(v)
+		}
+
+		if v := os.Difference(ns); v.Len() > 0 {
+			options.RemoveTransitGatewayCidrBlocks = flex.ExpandStringSet(v)
+		}
+	}
+
 	if d.HasChange("vpn_ecmp_support") {
 		transitGatewayModified = true
 		options.VpnEcmpSupport = aws.String(d.Get("vpn_ecmp_support").(string))
@@ -262,6 +295,10 @@ func resourceTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 		if _, err := conn.ModifyTransitGateway(modifyTransitGatewayInput); err != nil {
 			return fmt.Errorf("error updating EC2 Transit Gateway (%s) options: %s", d.Id(), err)
 		}
+
+		if err := waitForTransitGatewayUpdate(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for EC2 Transit Gateway (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("tags_all") {
@@ -758,6 +795,20 @@ func waitForTransitGatewayCreation(conn *ec2.EC2, transitGatewayID string) error
 	return err
 }
 
+func waitForTransitGatewayUpdate(conn *ec2.EC2, transitGatewayID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayStateModifying},
+		Target:  []string{ec2.TransitGatewayStateAvailable},
+		Refresh: transitGatewayRefreshFunc(conn, transitGatewayID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway (%s) update", transitGatewayID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
 func WaitForTransitGatewayDeletion(conn *ec2.EC2, transitGatewayID string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
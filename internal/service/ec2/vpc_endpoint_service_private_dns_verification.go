@@ -0,0 +1,119 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceVPCEndpointServicePrivateDNSVerification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPCEndpointServicePrivateDNSVerificationCreate,
+		Read:   resourceVPCEndpointServicePrivateDNSVerificationRead,
+		Delete: resourceVPCEndpointServicePrivateDNSVerificationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(VPCEndpointServicePrivateDNSNameVerifiedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_for_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceVPCEndpointServicePrivateDNSVerificationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	serviceID := d.Get("service_id").(string)
+	input := &ec2.StartVpcEndpointServicePrivateDnsVerificationInput{
+		ServiceId: aws.String(serviceID),
+	}
+
+	log.Printf("[DEBUG] Starting VPC Endpoint Service private DNS verification: %s", input)
+	_, err := conn.StartVpcEndpointServicePrivateDnsVerification(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting VPC Endpoint Service (%s) private DNS verification: %w", serviceID, err)
+	}
+
+	d.SetId(serviceID)
+
+	if d.Get("wait_for_verification").(bool) {
+		if _, err := WaitVPCEndpointServicePrivateDNSNameVerified(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for VPC Endpoint Service (%s) private DNS name verification: %w", d.Id(), err)
+		}
+	}
+
+	return resourceVPCEndpointServicePrivateDNSVerificationRead(d, meta)
+}
+
+func resourceVPCEndpointServicePrivateDNSVerificationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	serviceConfiguration, err := FindVPCEndpointServiceConfigurationByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Endpoint Service %s not found, removing private DNS verification from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading VPC Endpoint Service (%s): %w", d.Id(), err)
+	}
+
+	d.Set("service_id", serviceConfiguration.ServiceId)
+
+	if v := serviceConfiguration.PrivateDnsNameConfiguration; v != nil {
+		d.Set("name", v.Name)
+		d.Set("state", v.State)
+		d.Set("type", v.Type)
+		d.Set("value", v.Value)
+	} else {
+		d.Set("name", nil)
+		d.Set("state", nil)
+		d.Set("type", nil)
+		d.Set("value", nil)
+	}
+
+	return nil
+}
+
+func resourceVPCEndpointServicePrivateDNSVerificationDelete(d *schema.ResourceData, meta interface{}) error {
+	// Verification cannot be undone; removing the resource only removes it from state.
+	log.Printf("[WARN] VPC Endpoint Service (%s) private DNS verification cannot be undone, removing from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2VPCEndpointServicePrivateDNSVerification_basic(t *testing.T) {
+	var svcCfg ec2.ServiceConfiguration
+	resourceName := "aws_vpc_endpoint_service_private_dns_verification.test"
+	serviceResourceName := "aws_vpc_endpoint_service.test"
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVpcEndpointServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointServicePrivateDNSVerificationConfig(rName1, rName2, "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointServiceExists(serviceResourceName, &svcCfg),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", serviceResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", serviceResourceName, "private_dns_name_configuration.0.name"),
+					resource.TestCheckResourceAttrSet(resourceName, "state"),
+					resource.TestCheckResourceAttr(resourceName, "type", "TXT"),
+					resource.TestCheckResourceAttrPair(resourceName, "value", serviceResourceName, "private_dns_name_configuration.0.value"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_verification", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCEndpointServicePrivateDNSVerificationConfig(rName1, rName2, dnsName string) string {
+	return acctest.ConfigCompose(
+		testAccVpcEndpointServiceConfigPrivateDnsName(rName1, rName2, dnsName),
+		`
+resource "aws_vpc_endpoint_service_private_dns_verification" "test" {
+  service_id = aws_vpc_endpoint_service.test.id
+}
+`)
+}
@@ -0,0 +1,394 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSecurityGroupIngressRule() *schema.Resource {
+	return resourceSecurityGroupRuleStandalone(false)
+}
+
+// resourceSecurityGroupRuleStandalone returns the schema and CRUD functions shared by
+// the aws_vpc_security_group_ingress_rule and aws_vpc_security_group_egress_rule resources.
+func resourceSecurityGroupRuleStandalone(isEgress bool) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceSecurityGroupRuleStandaloneCreate(d, meta, isEgress)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceSecurityGroupRuleStandaloneRead(d, meta, isEgress)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceSecurityGroupRuleStandaloneUpdate(d, meta, isEgress)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceSecurityGroupRuleStandaloneDelete(d, meta, isEgress)
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr_ipv4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"cidr_ipv6": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validSecurityGroupRuleDescription,
+			},
+			"from_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+			"ip_protocol": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: ProtocolStateFunc,
+			},
+			"prefix_list_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"referenced_security_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"},
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"to_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+		},
+	}
+}
+
+func resourceSecurityGroupRuleStandaloneCreate(d *schema.ResourceData, meta interface{}, isEgress bool) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	securityGroupID := d.Get("security_group_id").(string)
+	ipPermission := expandSecurityGroupRuleStandaloneIPPermission(d)
+
+	var tagSpecifications []*ec2.TagSpecification
+
+	if len(tags) > 0 {
+		tagSpecifications = ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule)
+	}
+
+	var rules []*ec2.SecurityGroupRule
+
+	if isEgress {
+		input := &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:           aws.String(securityGroupID),
+			IpPermissions:     []*ec2.IpPermission{ipPermission},
+			TagSpecifications: tagSpecifications,
+		}
+
+		log.Printf("[DEBUG] Creating EC2 Security Group egress rule: %s", input)
+		output, err := conn.AuthorizeSecurityGroupEgress(input)
+
+		if err != nil {
+			return fmt.Errorf("error creating EC2 Security Group (%s) egress rule: %w", securityGroupID, err)
+		}
+
+		rules = output.SecurityGroupRules
+	} else {
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:           aws.String(securityGroupID),
+			IpPermissions:     []*ec2.IpPermission{ipPermission},
+			TagSpecifications: tagSpecifications,
+		}
+
+		log.Printf("[DEBUG] Creating EC2 Security Group ingress rule: %s", input)
+		output, err := conn.AuthorizeSecurityGroupIngress(input)
+
+		if err != nil {
+			return fmt.Errorf("error creating EC2 Security Group (%s) ingress rule: %w", securityGroupID, err)
+		}
+
+		rules = output.SecurityGroupRules
+	}
+
+	if len(rules) != 1 || rules[0] == nil {
+		return fmt.Errorf("error creating EC2 Security Group (%s) %s rule: unexpected number of rules (%d) returned", securityGroupID, securityGroupRuleStandaloneType(isEgress), len(rules))
+	}
+
+	d.SetId(aws.StringValue(rules[0].SecurityGroupRuleId))
+
+	return resourceSecurityGroupRuleStandaloneRead(d, meta, isEgress)
+}
+
+func resourceSecurityGroupRuleStandaloneRead(d *schema.ResourceData, meta interface{}, isEgress bool) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindSecurityGroupRuleByID(conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Security Group %s rule %s not found, removing from state", securityGroupRuleStandaloneType(isEgress), d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Security Group %s rule (%s): %w", securityGroupRuleStandaloneType(isEgress), d.Id(), err)
+	}
+
+	rule := outputRaw.(*ec2.SecurityGroupRule)
+
+	if aws.BoolValue(rule.IsEgress) != isEgress {
+		return fmt.Errorf("EC2 Security Group rule (%s) is not an %s rule", d.Id(), securityGroupRuleStandaloneType(isEgress))
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: aws.StringValue(rule.GroupOwnerId),
+		Resource:  fmt.Sprintf("security-group-rule/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("cidr_ipv4", rule.CidrIpv4)
+	d.Set("cidr_ipv6", rule.CidrIpv6)
+	d.Set("description", rule.Description)
+	d.Set("ip_protocol", rule.IpProtocol)
+	d.Set("prefix_list_id", rule.PrefixListId)
+	if v := rule.ReferencedGroupInfo; v != nil {
+		d.Set("referenced_security_group_id", v.GroupId)
+	} else {
+		d.Set("referenced_security_group_id", nil)
+	}
+	d.Set("security_group_id", rule.GroupId)
+	d.Set("security_group_rule_id", rule.SecurityGroupRuleId)
+
+	// Ports are meaningless for "all protocols" rules and are returned as -1.
+	if aws.StringValue(rule.IpProtocol) != "-1" {
+		d.Set("from_port", rule.FromPort)
+		d.Set("to_port", rule.ToPort)
+	} else {
+		d.Set("from_port", nil)
+		d.Set("to_port", nil)
+	}
+
+	tags := KeyValueTags(rule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSecurityGroupRuleStandaloneUpdate(d *schema.ResourceData, meta interface{}, isEgress bool) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId: aws.String(d.Get("security_group_id").(string)),
+			SecurityGroupRules: []*ec2.SecurityGroupRuleUpdate{{
+				SecurityGroupRule:   expandSecurityGroupRuleStandaloneRequest(d),
+				SecurityGroupRuleId: aws.String(d.Id()),
+			}},
+		}
+
+		log.Printf("[DEBUG] Updating EC2 Security Group %s rule: %s", securityGroupRuleStandaloneType(isEgress), input)
+		_, err := conn.ModifySecurityGroupRules(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating EC2 Security Group %s rule (%s): %w", securityGroupRuleStandaloneType(isEgress), d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Security Group %s rule (%s) tags: %w", securityGroupRuleStandaloneType(isEgress), d.Id(), err)
+		}
+	}
+
+	return resourceSecurityGroupRuleStandaloneRead(d, meta, isEgress)
+}
+
+func resourceSecurityGroupRuleStandaloneDelete(d *schema.ResourceData, meta interface{}, isEgress bool) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	securityGroupID := d.Get("security_group_id").(string)
+
+	log.Printf("[DEBUG] Deleting EC2 Security Group %s rule: %s", securityGroupRuleStandaloneType(isEgress), d.Id())
+	var err error
+	if isEgress {
+		_, err = conn.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice([]string{d.Id()}),
+		})
+	} else {
+		_, err = conn.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice([]string{d.Id()}),
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidSecurityGroupRuleIdNotFound, ErrCodeInvalidSecurityGroupIDNotFound, ErrCodeInvalidGroupNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Security Group %s rule (%s): %w", securityGroupRuleStandaloneType(isEgress), d.Id(), err)
+	}
+
+	return nil
+}
+
+func securityGroupRuleStandaloneType(isEgress bool) string {
+	if isEgress {
+		return "egress"
+	}
+
+	return "ingress"
+}
+
+func expandSecurityGroupRuleStandaloneIPPermission(d *schema.ResourceData) *ec2.IpPermission {
+	apiObject := &ec2.IpPermission{
+		IpProtocol: aws.String(ProtocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.IpRanges = []*ec2.IpRange{{
+			CidrIp: aws.String(v.(string)),
+		}}
+
+		if v, ok := d.GetOk("description"); ok {
+			apiObject.IpRanges[0].Description = aws.String(v.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6: aws.String(v.(string)),
+		}}
+
+		if v, ok := d.GetOk("description"); ok {
+			apiObject.Ipv6Ranges[0].Description = aws.String(v.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListIds = []*ec2.PrefixListId{{
+			PrefixListId: aws.String(v.(string)),
+		}}
+
+		if v, ok := d.GetOk("description"); ok {
+			apiObject.PrefixListIds[0].Description = aws.String(v.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{{
+			GroupId: aws.String(v.(string)),
+		}}
+
+		if v, ok := d.GetOk("description"); ok {
+			apiObject.UserIdGroupPairs[0].Description = aws.String(v.(string))
+		}
+	}
+
+	// A port value of 0 is meaningful (e.g. ICMP type 0).
+	if v, ok := d.GetOkExists("from_port"); ok {
+		apiObject.FromPort = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOkExists("to_port"); ok {
+		apiObject.ToPort = aws.Int64(int64(v.(int)))
+	}
+
+	return apiObject
+}
+
+func expandSecurityGroupRuleStandaloneRequest(d *schema.ResourceData) *ec2.SecurityGroupRuleRequest {
+	apiObject := &ec2.SecurityGroupRuleRequest{
+		IpProtocol: aws.String(ProtocolForValue(d.Get("ip_protocol").(string))),
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.CidrIpv4 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.CidrIpv6 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		apiObject.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("from_port"); ok {
+		apiObject.FromPort = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		apiObject.ReferencedGroupId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("to_port"); ok {
+		apiObject.ToPort = aws.Int64(int64(v.(int)))
+	}
+
+	return apiObject
+}
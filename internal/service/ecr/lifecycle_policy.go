@@ -2,7 +2,9 @@ package ecr
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"sort"
@@ -30,6 +32,8 @@ func ResourceLifecyclePolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceLifecyclePolicyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"repository": {
 				Type:     schema.TypeString,
@@ -38,8 +42,10 @@ func ResourceLifecyclePolicy() *schema.Resource {
 			},
 			"policy": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
+				ExactlyOneOf: []string{"policy", "rule"},
 				ValidateFunc: validation.StringIsJSON,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					equal, _ := equivalentLifecyclePolicyJSON(old, new)
@@ -55,6 +61,86 @@ func ResourceLifecyclePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"rule": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MinItems:     1,
+				ExactlyOneOf: []string{"policy", "rule"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      lifecyclePolicyActionTypeExpire,
+										ValidateFunc: validation.StringInSlice(lifecyclePolicyActionType_Values(), false),
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"selection": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count_number": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"count_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(lifecyclePolicyCountType_Values(), false),
+									},
+									"count_unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(lifecyclePolicyCountUnit_Values(), false),
+									},
+									"tag_prefix_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"tag_status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(lifecyclePolicyTagStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -62,10 +148,21 @@ func ResourceLifecyclePolicy() *schema.Resource {
 func resourceLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ECRConn
 
-	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+	var policy string
+	var err error
 
-	if err != nil {
-		return fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	if v, ok := d.GetOk("rule"); ok && len(v.([]interface{})) > 0 {
+		policy, err = expandLifecyclePolicyRules(v.([]interface{}))
+
+		if err != nil {
+			return fmt.Errorf("error building ECR Lifecycle Policy from rule: %w", err)
+		}
+	} else {
+		policy, err = structure.NormalizeJsonString(d.Get("policy").(string))
+
+		if err != nil {
+			return fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+		}
 	}
 
 	input := &ecr.PutLifecyclePolicyInput{
@@ -154,6 +251,20 @@ func resourceLifecyclePolicyRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("policy", policyToSet)
 	}
 
+	// Only populate rule when it is used in configuration so that policies
+	// managed via the policy argument (or imported) don't show a diff.
+	if v, ok := d.GetOk("rule"); ok && len(v.([]interface{})) > 0 {
+		rules, err := flattenLifecyclePolicyRules(aws.StringValue(resp.LifecyclePolicyText))
+
+		if err != nil {
+			return fmt.Errorf("error reading ECR Lifecycle Policy (%s) rules: %w", d.Id(), err)
+		}
+
+		if err := d.Set("rule", rules); err != nil {
+			return fmt.Errorf("error setting rule: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -178,12 +289,97 @@ func resourceLifecyclePolicyDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func resourceLifecyclePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("rule") {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("rule"); ok {
+		rules := expandLifecyclePolicyRuleList(v.([]interface{}))
+
+		// Rules are read back in priority order, so any other order would force replacement on every plan.
+		if !sort.SliceIsSorted(rules, func(i, j int) bool {
+			return aws.Int64Value(rules[i].RulePriority) < aws.Int64Value(rules[j].RulePriority)
+		}) {
+			return fmt.Errorf("rule blocks must be listed in ascending priority order")
+		}
+
+		return validateLifecyclePolicyRules(rules, lifecyclePolicyRuleFieldNames)
+	}
+
+	if !diff.NewValueKnown("policy") {
+		return nil
+	}
+
+	v, ok := diff.GetOk("policy")
+
+	if !ok {
+		return nil
+	}
+
+	var lp lifecyclePolicy
+
+	// Invalid JSON is reported by the attribute's ValidateFunc.
+	if err := json.Unmarshal([]byte(v.(string)), &lp); err != nil {
+		return nil
+	}
+
+	return validateLifecyclePolicyRules(lp.Rules, lifecyclePolicyJSONFieldNames)
+}
+
+const (
+	lifecyclePolicyActionTypeExpire = "expire"
+)
+
+func lifecyclePolicyActionType_Values() []string {
+	return []string{
+		lifecyclePolicyActionTypeExpire,
+	}
+}
+
+const (
+	lifecyclePolicyCountTypeImageCountMoreThan = "imageCountMoreThan"
+	lifecyclePolicyCountTypeSinceImagePushed   = "sinceImagePushed"
+)
+
+func lifecyclePolicyCountType_Values() []string {
+	return []string{
+		lifecyclePolicyCountTypeImageCountMoreThan,
+		lifecyclePolicyCountTypeSinceImagePushed,
+	}
+}
+
+const (
+	lifecyclePolicyCountUnitDays = "days"
+)
+
+func lifecyclePolicyCountUnit_Values() []string {
+	return []string{
+		lifecyclePolicyCountUnitDays,
+	}
+}
+
+const (
+	lifecyclePolicyTagStatusAny      = "any"
+	lifecyclePolicyTagStatusTagged   = "tagged"
+	lifecyclePolicyTagStatusUntagged = "untagged"
+)
+
+func lifecyclePolicyTagStatus_Values() []string {
+	return []string{
+		lifecyclePolicyTagStatusAny,
+		lifecyclePolicyTagStatusTagged,
+		lifecyclePolicyTagStatusUntagged,
+	}
+}
+
 type lifecyclePolicyRuleSelection struct {
-	TagStatus     *string   `locationName:"tagStatus" type:"string" enum:"tagStatus" required:"true"`
-	TagPrefixList []*string `locationName:"tagPrefixList" type:"list"`
-	CountType     *string   `locationName:"countType" type:"string" enum:"countType" required:"true"`
-	CountUnit     *string   `locationName:"countUnit" type:"string" enum:"countType"`
-	CountNumber   *int64    `locationName:"countNumber" min:"1" type:"integer"`
+	TagStatus      *string   `locationName:"tagStatus" type:"string" enum:"tagStatus" required:"true"`
+	TagPrefixList  []*string `locationName:"tagPrefixList" type:"list"`
+	TagPatternList []*string `locationName:"tagPatternList" type:"list"`
+	CountType      *string   `locationName:"countType" type:"string" enum:"countType" required:"true"`
+	CountUnit      *string   `locationName:"countUnit" type:"string" enum:"countType"`
+	CountNumber    *int64    `locationName:"countNumber" min:"1" type:"integer"`
 }
 
 type lifecyclePolicyRuleAction struct {
@@ -219,6 +415,14 @@ func (lprs *lifecyclePolicyRuleSelection) reduce() {
 	if len(lprs.TagPrefixList) == 0 {
 		lprs.TagPrefixList = nil
 	}
+
+	sort.Slice(lprs.TagPatternList, func(i, j int) bool {
+		return aws.StringValue(lprs.TagPatternList[i]) < aws.StringValue(lprs.TagPatternList[j])
+	})
+
+	if len(lprs.TagPatternList) == 0 {
+		lprs.TagPatternList = nil
+	}
 }
 
 func equivalentLifecyclePolicyJSON(str1, str2 string) (bool, error) {
@@ -264,3 +468,227 @@ func equivalentLifecyclePolicyJSON(str1, str2 string) (bool, error) {
 
 	return equal, nil
 }
+
+// lifecyclePolicyFieldNames holds the names of the selection fields used in validation errors,
+// so that errors refer to the rule block or the policy JSON as appropriate.
+type lifecyclePolicyFieldNames struct {
+	countType   string
+	countUnit   string
+	tagPrefixes string
+	tagStatus   string
+}
+
+var (
+	lifecyclePolicyRuleFieldNames = lifecyclePolicyFieldNames{
+		countType:   "count_type",
+		countUnit:   "count_unit",
+		tagPrefixes: "tag_prefix_list",
+		tagStatus:   "tag_status",
+	}
+	lifecyclePolicyJSONFieldNames = lifecyclePolicyFieldNames{
+		countType:   "countType",
+		countUnit:   "countUnit",
+		tagPrefixes: "tagPrefixList or tagPatternList",
+		tagStatus:   "tagStatus",
+	}
+)
+
+// validateLifecyclePolicyRules checks the constraints that the ECR API otherwise only reports at apply time.
+func validateLifecyclePolicyRules(rules []*lifecyclePolicyRule, names lifecyclePolicyFieldNames) error {
+	var errs []error
+	priorities := make(map[int64]bool)
+	var maxPriority int64
+
+	for _, rule := range rules {
+		priority := aws.Int64Value(rule.RulePriority)
+
+		if priorities[priority] {
+			errs = append(errs, fmt.Errorf("rule priority %d is not unique", priority))
+		}
+
+		priorities[priority] = true
+
+		if priority > maxPriority {
+			maxPriority = priority
+		}
+
+		selection := rule.Selection
+
+		if selection == nil {
+			continue
+		}
+
+		hasTagPrefixes := len(selection.TagPrefixList) > 0 || len(selection.TagPatternList) > 0
+
+		switch aws.StringValue(selection.TagStatus) {
+		case lifecyclePolicyTagStatusTagged:
+			if !hasTagPrefixes {
+				errs = append(errs, fmt.Errorf("rule %d: %s is required when %s is %q", priority, names.tagPrefixes, names.tagStatus, lifecyclePolicyTagStatusTagged))
+			}
+		default:
+			if hasTagPrefixes {
+				errs = append(errs, fmt.Errorf("rule %d: %s can only be set when %s is %q", priority, names.tagPrefixes, names.tagStatus, lifecyclePolicyTagStatusTagged))
+			}
+		}
+
+		switch aws.StringValue(selection.CountType) {
+		case lifecyclePolicyCountTypeSinceImagePushed:
+			if selection.CountUnit == nil {
+				errs = append(errs, fmt.Errorf("rule %d: %s is required when %s is %q", priority, names.countUnit, names.countType, lifecyclePolicyCountTypeSinceImagePushed))
+			}
+		case lifecyclePolicyCountTypeImageCountMoreThan:
+			if selection.CountUnit != nil {
+				errs = append(errs, fmt.Errorf("rule %d: %s cannot be set when %s is %q", priority, names.countUnit, names.countType, lifecyclePolicyCountTypeImageCountMoreThan))
+			}
+		}
+	}
+
+	for _, rule := range rules {
+		if rule.Selection != nil && aws.StringValue(rule.Selection.TagStatus) == lifecyclePolicyTagStatusAny && aws.Int64Value(rule.RulePriority) != maxPriority {
+			errs = append(errs, fmt.Errorf("rule %d: a rule with %s %q must have the highest priority", aws.Int64Value(rule.RulePriority), names.tagStatus, lifecyclePolicyTagStatusAny))
+		}
+	}
+
+	if len(errs) > 0 {
+		var msgs []string
+
+		for _, err := range errs {
+			msgs = append(msgs, err.Error())
+		}
+
+		return errors.New(strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+func expandLifecyclePolicyRules(tfList []interface{}) (string, error) {
+	lp := &lifecyclePolicy{
+		Rules: expandLifecyclePolicyRuleList(tfList),
+	}
+
+	if err := validateLifecyclePolicyRules(lp.Rules, lifecyclePolicyRuleFieldNames); err != nil {
+		return "", err
+	}
+
+	b, err := jsonutil.BuildJSON(lp)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func expandLifecyclePolicyRuleList(tfList []interface{}) []*lifecyclePolicyRule {
+	var apiObjects []*lifecyclePolicyRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lifecyclePolicyRule{
+			Action: &lifecyclePolicyRuleAction{
+				ActionType: aws.String(lifecyclePolicyActionTypeExpire),
+			},
+			RulePriority: aws.Int64(int64(tfMap["priority"].(int))),
+		}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})["type"].(string); ok && v != "" {
+				apiObject.Action.ActionType = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Selection = expandLifecyclePolicyRuleSelection(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLifecyclePolicyRuleSelection(tfMap map[string]interface{}) *lifecyclePolicyRuleSelection {
+	apiObject := &lifecyclePolicyRuleSelection{}
+
+	if v, ok := tfMap["count_number"].(int); ok && v > 0 {
+		apiObject.CountNumber = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["count_type"].(string); ok && v != "" {
+		apiObject.CountType = aws.String(v)
+	}
+
+	if v, ok := tfMap["count_unit"].(string); ok && v != "" {
+		apiObject.CountUnit = aws.String(v)
+	}
+
+	if v, ok := tfMap["tag_prefix_list"].([]interface{}); ok && len(v) > 0 {
+		for _, p := range v {
+			if p, ok := p.(string); ok {
+				apiObject.TagPrefixList = append(apiObject.TagPrefixList, aws.String(p))
+			}
+		}
+	}
+
+	if v, ok := tfMap["tag_status"].(string); ok && v != "" {
+		apiObject.TagStatus = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLifecyclePolicyRules(policy string) ([]interface{}, error) {
+	var lp lifecyclePolicy
+
+	if err := jsonutil.UnmarshalJSON(&lp, strings.NewReader(policy)); err != nil {
+		return nil, err
+	}
+
+	// ECR does not guarantee the order of the rules it returns.
+	sort.Slice(lp.Rules, func(i, j int) bool {
+		return aws.Int64Value(lp.Rules[i].RulePriority) < aws.Int64Value(lp.Rules[j].RulePriority)
+	})
+
+	var tfList []interface{}
+
+	for _, apiObject := range lp.Rules {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"priority":    aws.Int64Value(apiObject.RulePriority),
+		}
+
+		if v := apiObject.Action; v != nil {
+			tfMap["action"] = []interface{}{map[string]interface{}{
+				"type": aws.StringValue(v.ActionType),
+			}}
+		}
+
+		if v := apiObject.Selection; v != nil {
+			tfMap["selection"] = []interface{}{map[string]interface{}{
+				"count_number":    aws.Int64Value(v.CountNumber),
+				"count_type":      aws.StringValue(v.CountType),
+				"count_unit":      aws.StringValue(v.CountUnit),
+				"tag_prefix_list": aws.StringValueSlice(v.TagPrefixList),
+				"tag_status":      aws.StringValue(v.TagStatus),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
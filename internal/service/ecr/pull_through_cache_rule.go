@@ -45,6 +45,9 @@ func ResourcePullThroughCacheRule() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)+$`),
+					"must be a registry host name without a scheme or path, e.g., public.ecr.aws"),
 			},
 		},
 	}
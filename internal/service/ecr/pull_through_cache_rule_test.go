@@ -91,6 +91,27 @@ func TestAccPullThroughCacheRule_failWhenAlreadyExists(t *testing.T) {
 	})
 }
 
+func TestAccPullThroughCacheRule_upstreamRegistryURLInvalid(t *testing.T) {
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPullThroughCacheRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPullThroughCacheRuleConfig_upstreamRegistryURL(repositoryPrefix, "https://public.ecr.aws"),
+				ExpectError: regexp.MustCompile(`must be a registry host name`),
+			},
+			{
+				Config:      testAccPullThroughCacheRuleConfig_upstreamRegistryURL(repositoryPrefix, "quay.io/example"),
+				ExpectError: regexp.MustCompile(`must be a registry host name`),
+			},
+		},
+	})
+}
+
 func testAccCheckPullThroughCacheRuleDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
 
@@ -168,3 +189,12 @@ resource "aws_ecr_pull_through_cache_rule" "duplicate" {
 }
 `, repositoryPrefix)
 }
+
+func testAccPullThroughCacheRuleConfig_upstreamRegistryURL(repositoryPrefix, upstreamRegistryURL string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix = %[1]q
+  upstream_registry_url = %[2]q
+}
+`, repositoryPrefix, upstreamRegistryURL)
+}
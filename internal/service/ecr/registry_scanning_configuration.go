@@ -1,6 +1,7 @@
 package ecr
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -22,6 +23,8 @@ func ResourceRegistryScanningConfiguration() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceRegistryScanningConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -124,6 +127,29 @@ func resourceRegistryScanningConfigurationDelete(d *schema.ResourceData, meta in
 	return nil
 }
 
+func resourceRegistryScanningConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("scan_type") || !diff.NewValueKnown("rule") {
+		return nil
+	}
+
+	// CONTINUOUS_SCAN is only supported by enhanced scanning.
+	if scanType := diff.Get("scan_type").(string); scanType == ecr.ScanTypeBasic {
+		for _, rule := range diff.Get("rule").(*schema.Set).List() {
+			m, ok := rule.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if v := m["scan_frequency"].(string); v == ecr.ScanFrequencyContinuousScan {
+				return fmt.Errorf("scan_frequency %q is not supported with scan_type %q, use %q", v, scanType, ecr.ScanTypeEnhanced)
+			}
+		}
+	}
+
+	return nil
+}
+
 // Helper functions
 
 func expandEcrScanningRegistryRules(l []interface{}) []*ecr.RegistryScanningRule {
@@ -589,7 +589,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 			cluster = v.(string)
 		}
 
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+		if err := waitServiceStable(conn, d.Id(), cluster, rollbackDeploymentID(d, output.Service)); err != nil {
 			return fmt.Errorf("error waiting for ECS service (%s) to become ready: %w", d.Id(), err)
 		}
 	}
@@ -800,6 +800,34 @@ func flattenDeploymentController(deploymentController *ecs.DeploymentController)
 	return []interface{}{m}
 }
 
+// rollbackDeploymentID returns the ID of the service's primary deployment if the deployment circuit breaker
+// may roll it back, otherwise "".
+func rollbackDeploymentID(d *schema.ResourceData, service *ecs.Service) string {
+	if !d.Get("deployment_circuit_breaker.0.rollback").(bool) {
+		return ""
+	}
+
+	if v := primaryServiceDeployment(service); v != nil {
+		return aws.StringValue(v.Id)
+	}
+
+	return ""
+}
+
+func primaryServiceDeployment(service *ecs.Service) *ecs.Deployment {
+	if service == nil {
+		return nil
+	}
+
+	for _, v := range service.Deployments {
+		if v != nil && aws.StringValue(v.Status) == serviceDeploymentStatusPrimary {
+			return v
+		}
+	}
+
+	return nil
+}
+
 func expandDeploymentCircuitBreaker(tfMap map[string]interface{}) *ecs.DeploymentCircuitBreaker {
 	if tfMap == nil {
 		return nil
@@ -1001,6 +1029,7 @@ func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface
 func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ECSConn
 	updateService := false
+	var deploymentID string
 
 	input := ecs.UpdateServiceInput{
 		Cluster:            aws.String(d.Get("cluster").(string)),
@@ -1114,8 +1143,10 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 	if updateService {
 		log.Printf("[DEBUG] Updating ECS Service (%s): %s", d.Id(), input)
 		// Retry due to IAM eventual consistency
+		var output *ecs.UpdateServiceOutput
 		err := resource.Retry(tfiam.PropagationTimeout+serviceUpdateTimeout, func() *resource.RetryError {
-			_, err := conn.UpdateService(&input)
+			var err error
+			output, err = conn.UpdateService(&input)
 
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "verify that the ECS service role being passed has the proper permissions") {
@@ -1132,12 +1163,16 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateService(&input)
+			output, err = conn.UpdateService(&input)
 		}
 
 		if err != nil {
 			return fmt.Errorf("error updating ECS Service (%s): %w", d.Id(), err)
 		}
+
+		if output != nil {
+			deploymentID = rollbackDeploymentID(d, output.Service)
+		}
 	}
 
 	if d.Get("wait_for_steady_state").(bool) {
@@ -1146,7 +1181,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 			cluster = v.(string)
 		}
 
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+		if err := waitServiceStable(conn, d.Id(), cluster, deploymentID); err != nil {
 			return fmt.Errorf("error waiting for ECS service (%s) to become ready: %w", d.Id(), err)
 		}
 	}
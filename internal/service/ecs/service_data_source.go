@@ -3,6 +3,7 @@ package ecs
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -27,6 +28,58 @@ func DataSourceService() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"deployments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"desired_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"failed_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pending_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rollout_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rollout_state_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"running_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"task_definition": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"desired_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -84,5 +137,47 @@ func dataSourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("scheduling_strategy", service.SchedulingStrategy)
 	d.Set("task_definition", service.TaskDefinition)
 
+	if err := d.Set("deployments", flattenServiceDeployments(service.Deployments)); err != nil {
+		return fmt.Errorf("error setting deployments: %w", err)
+	}
+
 	return nil
 }
+
+func flattenServiceDeployments(apiObjects []*ecs.Deployment) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"desired_count":        aws.Int64Value(apiObject.DesiredCount),
+			"failed_tasks":         aws.Int64Value(apiObject.FailedTasks),
+			"id":                   aws.StringValue(apiObject.Id),
+			"pending_count":        aws.Int64Value(apiObject.PendingCount),
+			"rollout_state":        aws.StringValue(apiObject.RolloutState),
+			"rollout_state_reason": aws.StringValue(apiObject.RolloutStateReason),
+			"running_count":        aws.Int64Value(apiObject.RunningCount),
+			"status":               aws.StringValue(apiObject.Status),
+			"task_definition":      aws.StringValue(apiObject.TaskDefinition),
+		}
+
+		if v := apiObject.CreatedAt; v != nil {
+			tfMap["created_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.UpdatedAt; v != nil {
+			tfMap["updated_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
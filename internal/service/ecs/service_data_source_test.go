@@ -29,6 +29,9 @@ func TestAccECSServiceDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "scheduling_strategy", dataSourceName, "scheduling_strategy"),
 					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "service_name"),
 					resource.TestCheckResourceAttrPair(resourceName, "task_definition", dataSourceName, "task_definition"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.0.status", "PRIMARY"),
+					resource.TestCheckResourceAttrPair(resourceName, "task_definition", dataSourceName, "deployments.0.task_definition"),
 				),
 			},
 		},
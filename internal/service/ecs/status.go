@@ -20,6 +20,8 @@ const (
 	serviceStatusError = "ERROR"
 	serviceStatusNone  = "NONE"
 
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
 	clusterStatusError = "ERROR"
 	clusterStatusNone  = "NONE"
 
@@ -88,6 +88,32 @@ func TestAccECSTag_ResourceARN_batchComputeEnvironment(t *testing.T) {
 	})
 }
 
+func TestAccECSTag_ResourceARN_service(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfigResourceArnService(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_ecs_service.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccECSTag_value(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_tag.test"
@@ -181,6 +207,34 @@ resource "aws_ecs_tag" "test" {
 `, rName)
 }
 
+func testAccTagConfigResourceArnService(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_service" "test" {
+  cluster       = aws_ecs_cluster.test.id
+  desired_count = 0
+  name          = %[1]q
+
+  deployment_controller {
+    type = "EXTERNAL"
+  }
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_ecs_tag" "test" {
+  resource_arn = aws_ecs_service.test.id
+  key          = "testkey"
+  value        = "testvalue"
+}
+`, rName)
+}
+
 func testAccPreCheckBatch(t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).BatchConn
 
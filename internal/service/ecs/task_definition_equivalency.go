@@ -58,6 +58,7 @@ type containerDefinitions []*ecs.ContainerDefinition
 func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 	// Deal with fields which may be re-ordered in the API
 	cd.OrderEnvironmentVariables()
+	cd.OrderSecrets()
 
 	for i, def := range cd {
 		// Deal with special fields which have defaults
@@ -78,6 +79,16 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 				cd[i].PortMappings[j].HostPort = cd[i].PortMappings[j].ContainerPort
 			}
 		}
+		for _, mp := range def.MountPoints {
+			if mp.ReadOnly != nil && !*mp.ReadOnly {
+				mp.ReadOnly = nil
+			}
+		}
+		for _, vf := range def.VolumesFrom {
+			if vf.ReadOnly != nil && !*vf.ReadOnly {
+				vf.ReadOnly = nil
+			}
+		}
 
 		// Create a mutable copy
 		defCopy, err := copystructure.Copy(def)
@@ -89,8 +100,8 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 		for i := 0; i < definition.NumField(); i++ {
 			sf := definition.Field(i)
 
-			// Set all empty slices to nil
-			if sf.Kind() == reflect.Slice {
+			// Set all empty slices and maps to nil
+			if sf.Kind() == reflect.Slice || sf.Kind() == reflect.Map {
 				if sf.IsValid() && !sf.IsNil() && sf.Len() == 0 {
 					sf.Set(reflect.Zero(sf.Type()))
 				}
@@ -99,6 +110,12 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 		iface := definition.Interface().(ecs.ContainerDefinition)
 		cd[i] = &iface
 	}
+
+	// The order of container definitions is not significant
+	sort.SliceStable(cd, func(i, j int) bool {
+		return aws.StringValue(cd[i].Name) < aws.StringValue(cd[j].Name)
+	})
+
 	return nil
 }
 
@@ -109,3 +126,11 @@ func (cd containerDefinitions) OrderEnvironmentVariables() {
 		})
 	}
 }
+
+func (cd containerDefinitions) OrderSecrets() {
+	for _, def := range cd {
+		sort.Slice(def.Secrets, func(i, j int) bool {
+			return aws.StringValue(def.Secrets[i].Name) < aws.StringValue(def.Secrets[j].Name)
+		})
+	}
+}
@@ -563,3 +563,104 @@ func TestContainerDefinitionsAreEquivalent_missingEnvironmentName(t *testing.T)
 		t.Fatal("Expected definitions to be equal.")
 	}
 }
+
+func TestContainerDefinitionsAreEquivalent_containerOrder(t *testing.T) {
+	cfgRepresention := `
+[
+    {"name": "wordpress", "image": "wordpress", "memory": 500},
+    {"name": "mysql", "image": "mysql", "memory": 500}
+]`
+
+	apiRepresentation := `
+[
+    {"name": "mysql", "image": "mysql", "memory": 500, "essential": true},
+    {"name": "wordpress", "image": "wordpress", "memory": 500, "essential": true}
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
+
+func TestContainerDefinitionsAreEquivalent_secretsOrder(t *testing.T) {
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "memory": 500,
+      "secrets": [
+        {"name": "DB_PASSWORD", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/db_password"},
+        {"name": "API_KEY", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/api_key"}
+      ]
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "memory": 500,
+      "essential": true,
+      "secrets": [
+        {"name": "API_KEY", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/api_key"},
+        {"name": "DB_PASSWORD", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/db_password"}
+      ]
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
+
+func TestContainerDefinitionsAreEquivalent_defaultReadOnly(t *testing.T) {
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "memory": 500,
+      "mountPoints": [
+        {"sourceVolume": "data", "containerPath": "/data"}
+      ],
+      "volumesFrom": [
+        {"sourceContainer": "sidecar"}
+      ],
+      "dockerLabels": {}
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "memory": 500,
+      "essential": true,
+      "mountPoints": [
+        {"sourceVolume": "data", "containerPath": "/data", "readOnly": false}
+      ],
+      "volumesFrom": [
+        {"sourceContainer": "sidecar", "readOnly": false}
+      ]
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
@@ -2,6 +2,7 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -62,7 +63,10 @@ func waitCapacityProviderUpdated(conn *ecs.ECS, arn string) (*ecs.CapacityProvid
 	return nil, err
 }
 
-func waitServiceStable(conn *ecs.ECS, id, cluster string) error {
+// waitServiceStable waits for the service to reach a steady state.
+// If deploymentID is set, the deployment must still be the service's primary deployment once the
+// service is stable, i.e. it must not have been rolled back by the deployment circuit breaker.
+func waitServiceStable(conn *ecs.ECS, id, cluster, deploymentID string) error {
 	input := &ecs.DescribeServicesInput{
 		Services: aws.StringSlice([]string{id}),
 	}
@@ -74,7 +78,38 @@ func waitServiceStable(conn *ecs.ECS, id, cluster string) error {
 	if err := conn.WaitUntilServicesStable(input); err != nil {
 		return err
 	}
-	return nil
+
+	if deploymentID == "" {
+		return nil
+	}
+
+	output, err := conn.DescribeServices(input)
+
+	if err != nil {
+		return err
+	}
+
+	if len(output.Services) == 0 || output.Services[0] == nil {
+		return fmt.Errorf("service not found")
+	}
+
+	return serviceDeploymentError(output.Services[0], deploymentID)
+}
+
+// serviceDeploymentError returns an error if the deployment of a stable service failed,
+// or was rolled back and so is no longer the primary deployment.
+func serviceDeploymentError(service *ecs.Service, deploymentID string) error {
+	primary := primaryServiceDeployment(service)
+
+	if primary != nil && aws.StringValue(primary.Id) == deploymentID {
+		if state := aws.StringValue(primary.RolloutState); state == ecs.DeploymentRolloutStateFailed {
+			return fmt.Errorf("deployment (%s) %s: %s", deploymentID, state, aws.StringValue(primary.RolloutStateReason))
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("deployment (%s) failed and was rolled back by the deployment circuit breaker", deploymentID)
 }
 
 func waitServiceInactive(conn *ecs.ECS, id, cluster string) error {
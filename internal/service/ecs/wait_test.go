@@ -0,0 +1,171 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestPrimaryServiceDeployment(t *testing.T) {
+	primary := &ecs.Deployment{
+		Id:     aws.String("ecs-svc/2"),
+		Status: aws.String(serviceDeploymentStatusPrimary),
+	}
+
+	testCases := []struct {
+		Name     string
+		Service  *ecs.Service
+		Expected *ecs.Deployment
+	}{
+		{
+			Name: "nil service",
+		},
+		{
+			Name:    "no deployments",
+			Service: &ecs.Service{},
+		},
+		{
+			Name: "no primary deployment",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("ACTIVE")},
+				},
+			},
+		},
+		{
+			Name: "primary deployment",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					nil,
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("ACTIVE")},
+					primary,
+				},
+			},
+			Expected: primary,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := primaryServiceDeployment(testCase.Service); got != testCase.Expected {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRollbackDeploymentID(t *testing.T) {
+	service := &ecs.Service{
+		Deployments: []*ecs.Deployment{
+			{Id: aws.String("ecs-svc/1"), Status: aws.String("ACTIVE")},
+			{Id: aws.String("ecs-svc/2"), Status: aws.String(serviceDeploymentStatusPrimary)},
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		Raw      map[string]interface{}
+		Service  *ecs.Service
+		Expected string
+	}{
+		{
+			Name:    "no circuit breaker",
+			Raw:     map[string]interface{}{},
+			Service: service,
+		},
+		{
+			Name: "rollback disabled",
+			Raw: map[string]interface{}{
+				"deployment_circuit_breaker": []interface{}{
+					map[string]interface{}{"enable": true, "rollback": false},
+				},
+			},
+			Service: service,
+		},
+		{
+			Name: "rollback enabled",
+			Raw: map[string]interface{}{
+				"deployment_circuit_breaker": []interface{}{
+					map[string]interface{}{"enable": true, "rollback": true},
+				},
+			},
+			Service:  service,
+			Expected: "ecs-svc/2",
+		},
+		{
+			Name: "rollback enabled without primary deployment",
+			Raw: map[string]interface{}{
+				"deployment_circuit_breaker": []interface{}{
+					map[string]interface{}{"enable": true, "rollback": true},
+				},
+			},
+			Service: &ecs.Service{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceService().Schema, testCase.Raw)
+
+			if got := rollbackDeploymentID(d, testCase.Service); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestServiceDeploymentError(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Service     *ecs.Service
+		ExpectError bool
+	}{
+		{
+			Name: "completed",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted), Status: aws.String(serviceDeploymentStatusPrimary)},
+				},
+			},
+		},
+		{
+			Name: "failed",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), RolloutStateReason: aws.String("tasks failed to start"), Status: aws.String(serviceDeploymentStatusPrimary)},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "rolled back",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted), Status: aws.String(serviceDeploymentStatusPrimary)},
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:        "no primary deployment",
+			Service:     &ecs.Service{},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := serviceDeploymentError(testCase.Service, "ecs-svc/2")
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, but received none")
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package efs
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -28,7 +30,10 @@ func ResourceFileSystem() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceFileSystemCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -281,6 +286,28 @@ func resourceFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceFileSystemRead(d, meta)
 }
 
+func resourceFileSystemCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("throughput_mode") || !diff.NewValueKnown("provisioned_throughput_in_mibps") {
+		return nil
+	}
+
+	throughputMode := diff.Get("throughput_mode").(string)
+	provisionedThroughputInMibps := diff.Get("provisioned_throughput_in_mibps").(float64)
+
+	switch throughputMode {
+	case efs.ThroughputModeProvisioned:
+		if provisionedThroughputInMibps <= 0 {
+			return fmt.Errorf("provisioned_throughput_in_mibps must be set when throughput_mode is %q", throughputMode)
+		}
+	default:
+		if provisionedThroughputInMibps > 0 {
+			return fmt.Errorf("provisioned_throughput_in_mibps can only be set when throughput_mode is %q", efs.ThroughputModeProvisioned)
+		}
+	}
+
+	return nil
+}
+
 func resourceFileSystemRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EFSConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -219,6 +219,25 @@ func TestAccEFSFileSystem_kmsWithoutEncryption(t *testing.T) {
 	})
 }
 
+func TestAccEFSFileSystem_throughputModeValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, efs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEfsFileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFileSystemConfig_ThroughputMode(efs.ThroughputModeProvisioned),
+				ExpectError: regexp.MustCompile(`provisioned_throughput_in_mibps must be set when throughput_mode is "provisioned"`),
+			},
+			{
+				Config:      testAccFileSystemConfig_ThroughputModeWithProvisionedThroughputInMibps(efs.ThroughputModeBursting, 1.0),
+				ExpectError: regexp.MustCompile(`provisioned_throughput_in_mibps can only be set when throughput_mode is "provisioned"`),
+			},
+		},
+	})
+}
+
 func TestAccEFSFileSystem_provisionedThroughputInMibps(t *testing.T) {
 	var desc efs.FileSystemDescription
 	resourceName := "aws_efs_file_system.test"
@@ -585,6 +604,15 @@ resource "aws_efs_file_system" "test" {
 `, throughputMode)
 }
 
+func testAccFileSystemConfig_ThroughputModeWithProvisionedThroughputInMibps(throughputMode string, provisionedThroughputInMibps float64) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {
+  provisioned_throughput_in_mibps = %[2]f
+  throughput_mode                 = %[1]q
+}
+`, throughputMode, provisionedThroughputInMibps)
+}
+
 func testAccFileSystemConfig_ProvisionedThroughputInMibps(provisionedThroughputInMibps float64) string {
 	return fmt.Sprintf(`
 resource "aws_efs_file_system" "test" {
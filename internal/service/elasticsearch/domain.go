@@ -485,6 +485,11 @@ func ResourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_change_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -495,6 +500,7 @@ func ResourceDomain() *schema.Resource {
 func resourceDomainImport(
 	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("domain_name", d.Id())
+	d.Set("wait_for_change_completion", true)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -852,7 +858,7 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 func resourceDomainUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).ElasticsearchConn
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("tags", "tags_all", "wait_for_change_completion") {
 		input := elasticsearch.UpdateElasticsearchDomainConfigInput{
 			DomainName: aws.String(d.Get("domain_name").(string)),
 		}
@@ -929,13 +935,26 @@ func resourceDomainUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.LogPublishingOptions = expandLogPublishingOptions(d.Get("log_publishing_options").(*schema.Set))
 		}
 
-		_, err := conn.UpdateElasticsearchDomainConfig(&input)
+		output, err := conn.UpdateElasticsearchDomainConfig(&input)
 		if err != nil {
 			return err
 		}
 
-		if err := waitForDomainUpdate(conn, d.Get("domain_name").(string)); err != nil {
-			return fmt.Errorf("error waiting for Elasticsearch Domain Update (%s) to succeed: %w", d.Id(), err)
+		// A version upgrade cannot start while the configuration change is still being processed.
+		if d.Get("wait_for_change_completion").(bool) || d.HasChange("elasticsearch_version") {
+			// Configuration changes that trigger a blue/green deployment can take a long time.
+			// Follow the change's progress so that its stages show up in the logs.
+			if changeID := changeProgressID(output.DomainConfig); changeID != "" {
+				if _, err := waitDomainChangeCompleted(conn, d.Get("domain_name").(string), changeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for Elasticsearch Domain (%s) change (%s) to complete: %w", d.Id(), changeID, err)
+				}
+			}
+
+			if err := waitForDomainUpdate(conn, d.Get("domain_name").(string)); err != nil {
+				return fmt.Errorf("error waiting for Elasticsearch Domain Update (%s) to succeed: %w", d.Id(), err)
+			}
+		} else {
+			log.Printf("[INFO] Not waiting for Elasticsearch Domain (%s) configuration change to complete", d.Id())
 		}
 
 		if d.HasChange("elasticsearch_version") {
@@ -989,6 +1008,14 @@ func resourceDomainDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func changeProgressID(config *elasticsearch.ElasticsearchDomainConfig) string {
+	if config == nil || config.ChangeProgressDetails == nil {
+		return ""
+	}
+
+	return aws.StringValue(config.ChangeProgressDetails.ChangeId)
+}
+
 func suppressEquivalentKmsKeyIds(k, old, new string, d *schema.ResourceData) bool {
 	// The Elasticsearch API accepts a short KMS key id but always returns the ARN of the key.
 	// The ARN is of the format 'arn:aws:kms:REGION:ACCOUNT_ID:key/KMS_KEY_ID'.
@@ -45,6 +45,7 @@ func TestAccElasticsearchDomain_basic(t *testing.T) {
 					resource.TestMatchResourceAttr(resourceName, "kibana_endpoint", regexp.MustCompile(`.*es\..*/_plugin/kibana/`)),
 					resource.TestCheckResourceAttr(resourceName, "vpc_options.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_change_completion", "true"),
 				),
 			},
 			{
@@ -1150,6 +1151,47 @@ func TestAccElasticsearchDomain_update(t *testing.T) {
 		}})
 }
 
+func TestAccElasticsearchDomain_waitForChangeCompletion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var domain elasticsearch.ElasticsearchDomainStatus
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticsearch_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticsearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_waitForChangeCompletion(rName, 22, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(resourceName, &domain),
+					testAccCheckSnapshotHour(22, &domain),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_change_completion", "true"),
+				),
+			},
+			{
+				Config: testAccDomainConfig_waitForChangeCompletion(rName, 23, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(resourceName, &domain),
+					testAccCheckSnapshotHour(23, &domain),
+				),
+			},
+			{
+				Config: testAccDomainConfig_waitForChangeCompletion(rName, 21, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_change_completion", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElasticsearchDomain_UpdateVolume_type(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1797,6 +1839,25 @@ resource "aws_elasticsearch_domain" "test" {
 `, rName, instanceInt, snapshotInt)
 }
 
+func testAccDomainConfig_waitForChangeCompletion(rName string, snapshotInt int, waitForChangeCompletion bool) string {
+	return fmt.Sprintf(`
+resource "aws_elasticsearch_domain" "test" {
+  domain_name = substr(%[1]q, 0, 28)
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  snapshot_options {
+    automated_snapshot_start_hour = %[2]d
+  }
+
+  wait_for_change_completion = %[3]t
+}
+`, rName, snapshotInt, waitForChangeCompletion)
+}
+
 func testAccDomainConfig_ClusterUpdateEBSVolume(rName string, volumeSize int) string {
 	return fmt.Sprintf(`
 resource "aws_elasticsearch_domain" "test" {
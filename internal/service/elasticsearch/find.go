@@ -31,3 +31,28 @@ func FindDomainByName(conn *elasticsearch.ElasticsearchService, name string) (*e
 
 	return output.DomainStatus, nil
 }
+
+func FindDomainChangeProgressByNameAndChangeID(conn *elasticsearch.ElasticsearchService, name, changeID string) (*elasticsearch.ChangeProgressStatusDetails, error) {
+	input := &elasticsearch.DescribeDomainChangeProgressInput{
+		ChangeId:   aws.String(changeID),
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDomainChangeProgress(input)
+	if tfawserr.ErrCodeEquals(err, elasticsearch.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ChangeProgressStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChangeProgressStatus, nil
+}
@@ -1,9 +1,12 @@
 package elasticsearch
 
 import (
+	"log"
+
 	"github.com/aws/aws-sdk-go/aws"
 	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -29,3 +32,38 @@ func statusUpgradeStatus(conn *elasticsearch.ElasticsearchService, name string)
 		return out, aws.StringValue(out.StepStatus), nil
 	}
 }
+
+// statusDomainChangeProgress fetches the progress of a domain configuration change,
+// logging each stage as its status changes.
+func statusDomainChangeProgress(conn *elasticsearch.ElasticsearchService, name, changeID string) resource.StateRefreshFunc {
+	stageStatuses := make(map[string]string)
+
+	return func() (interface{}, string, error) {
+		output, err := FindDomainChangeProgressByNameAndChangeID(conn, name, changeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, stage := range output.ChangeProgressStages {
+			if stage == nil {
+				continue
+			}
+
+			stageName, status := aws.StringValue(stage.Name), aws.StringValue(stage.Status)
+
+			if stageStatuses[stageName] == status {
+				continue
+			}
+
+			stageStatuses[stageName] = status
+			log.Printf("[INFO] Elasticsearch Domain (%s) change (%s) stage %s: %s (%s)", name, changeID, stageName, status, aws.StringValue(stage.Description))
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -2,6 +2,7 @@ package elasticsearch
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,10 +12,12 @@ import (
 )
 
 const (
-	domainUpgradeSuccessMinTimeout = 10 * time.Second
-	domainUpgradeSuccessDelay      = 30 * time.Second
-	domainRetryTimeout             = 60 * time.Minute
-	domainDeleteRetryTimeout       = 90 * time.Minute
+	domainChangeCompletedMinTimeout = 10 * time.Second
+	domainChangeCompletedDelay      = 30 * time.Second
+	domainUpgradeSuccessMinTimeout  = 10 * time.Second
+	domainUpgradeSuccessDelay       = 30 * time.Second
+	domainRetryTimeout              = 60 * time.Minute
+	domainDeleteRetryTimeout        = 90 * time.Minute
 )
 
 // UpgradeSucceeded waits for an Upgrade to return Success
@@ -37,6 +40,29 @@ func waitUpgradeSucceeded(conn *elasticsearch.ElasticsearchService, name string,
 	return nil, err
 }
 
+func waitDomainChangeCompleted(conn *elasticsearch.ElasticsearchService, name, changeID string, timeout time.Duration) (*elasticsearch.ChangeProgressStatusDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{elasticsearch.OverallChangeStatusPending, elasticsearch.OverallChangeStatusProcessing},
+		Target:     []string{elasticsearch.OverallChangeStatusCompleted},
+		Refresh:    statusDomainChangeProgress(conn, name, changeID),
+		Timeout:    timeout,
+		MinTimeout: domainChangeCompletedMinTimeout,
+		Delay:      domainChangeCompletedDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*elasticsearch.ChangeProgressStatusDetails); ok {
+		if status := aws.StringValue(output.Status); status == elasticsearch.OverallChangeStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("pending properties: %s", strings.Join(aws.StringValueSlice(output.PendingProperties), ", ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitForDomainCreation(conn *elasticsearch.ElasticsearchService, domainName string) error {
 	var out *elasticsearch.ElasticsearchDomainStatus
 	err := resource.Retry(domainRetryTimeout, func() *resource.RetryError {
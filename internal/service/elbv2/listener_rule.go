@@ -902,15 +902,12 @@ func retryListenerRuleCreate(conn *elbv2.ELBV2, d *schema.ResourceData, params *
 			return nil, err
 		}
 	} else {
-		var priority int64
-
 		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 			var err error
-			priority, err = highestListenerRulePriority(conn, listenerARN)
+			params.Priority, err = nextListenerRulePriority(conn, listenerARN)
 			if err != nil {
 				return resource.NonRetryableError(err)
 			}
-			params.Priority = aws.Int64(priority + 1)
 			resp, err = conn.CreateRule(params)
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, elbv2.ErrCodePriorityInUseException, "") {
@@ -922,11 +919,10 @@ func retryListenerRuleCreate(conn *elbv2.ELBV2, d *schema.ResourceData, params *
 		})
 
 		if tfresource.TimedOut(err) {
-			priority, err = highestListenerRulePriority(conn, listenerARN)
+			params.Priority, err = nextListenerRulePriority(conn, listenerARN)
 			if err != nil {
-				return nil, fmt.Errorf("getting highest listener rule (%s) priority: %w", listenerARN, err)
+				return nil, fmt.Errorf("getting next listener rule (%s) priority: %w", listenerARN, err)
 			}
-			params.Priority = aws.Int64(priority + 1)
 			resp, err = conn.CreateRule(params)
 		}
 
@@ -966,17 +962,20 @@ func ListenerARNFromRuleARN(ruleArn string) string {
 	return ""
 }
 
-func highestListenerRulePriority(conn *elbv2.ELBV2, arn string) (priority int64, err error) {
+// nextListenerRulePriority returns the priority to assign to a new rule on the listener when none is configured.
+// This is one more than the highest existing priority or, once the maximum priority is in use,
+// the lowest priority not in use.
+func nextListenerRulePriority(conn *elbv2.ELBV2, arn string) (*int64, error) {
 	var priorities []int
 	var nextMarker *string
 
 	for {
-		out, aerr := conn.DescribeRules(&elbv2.DescribeRulesInput{
+		out, err := conn.DescribeRules(&elbv2.DescribeRulesInput{
 			ListenerArn: aws.String(arn),
 			Marker:      nextMarker,
 		})
-		if aerr != nil {
-			return 0, aerr
+		if err != nil {
+			return nil, err
 		}
 		for _, rule := range out.Rules {
 			if aws.StringValue(rule.Priority) != "default" {
@@ -990,13 +989,41 @@ func highestListenerRulePriority(conn *elbv2.ELBV2, arn string) (priority int64,
 		nextMarker = out.NextMarker
 	}
 
+	priority, err := nextRulePriority(priorities)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return aws.Int64(int64(priority)), nil
+}
+
+func nextRulePriority(priorities []int) (int, error) {
 	if len(priorities) == 0 {
-		return 0, nil
+		return listenerRulePriorityMin, nil
 	}
 
-	sort.IntSlice(priorities).Sort()
+	sort.Ints(priorities)
+
+	if highest := priorities[len(priorities)-1]; highest < listenerRulePriorityMax {
+		return highest + 1, nil
+	}
+
+	next := listenerRulePriorityMin
+	for _, p := range priorities {
+		if p > next {
+			break
+		}
+		if p == next {
+			next++
+		}
+	}
+
+	if next > listenerRulePriorityMax {
+		return 0, fmt.Errorf("no listener rule priorities in the range %d-%d are available", listenerRulePriorityMin, listenerRulePriorityMax)
+	}
 
-	return int64(priorities[len(priorities)-1]), nil
+	return next, nil
 }
 
 // lbListenerRuleConditions converts data source generated by Terraform into
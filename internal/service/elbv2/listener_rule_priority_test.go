@@ -0,0 +1,66 @@
+package elbv2
+
+import (
+	"testing"
+)
+
+func TestNextRulePriority(t *testing.T) {
+	maxInUse := make([]int, 0, listenerRulePriorityMax)
+	for i := listenerRulePriorityMin; i <= listenerRulePriorityMax; i++ {
+		maxInUse = append(maxInUse, i)
+	}
+
+	testCases := []struct {
+		name       string
+		priorities []int
+		want       int
+		wantErr    bool
+	}{
+		{
+			name: "no rules",
+			want: 1,
+		},
+		{
+			name:       "after highest",
+			priorities: []int{10, 3, 7},
+			want:       11,
+		},
+		{
+			name:       "lowest gap when maximum in use",
+			priorities: []int{listenerRulePriorityMax, 2, 1, 4},
+			want:       3,
+		},
+		{
+			name:       "below lowest when maximum in use",
+			priorities: []int{listenerRulePriorityMax, 5},
+			want:       1,
+		},
+		{
+			name:       "all in use",
+			priorities: maxInUse,
+			wantErr:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := nextRulePriority(testCase.priorities)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %d, expected %d", got, testCase.want)
+			}
+		})
+	}
+}
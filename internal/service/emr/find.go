@@ -94,6 +94,10 @@ func FindStudioSessionMappingByID(conn *emr.EMR, id string) (*emr.SessionMapping
 		IdentityId:   aws.String(identityId),
 	}
 
+	return FindStudioSessionMapping(conn, input)
+}
+
+func FindStudioSessionMapping(conn *emr.EMR, input *emr.GetStudioSessionMappingInput) (*emr.SessionMappingDetail, error) {
 	output, err := conn.GetStudioSessionMapping(input)
 
 	if tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "Studio session mapping does not exist") ||
@@ -114,3 +118,36 @@ func FindStudioSessionMappingByID(conn *emr.EMR, id string) (*emr.SessionMapping
 
 	return output.SessionMapping, nil
 }
+
+func FindStudioSessionMappings(conn *emr.EMR, input *emr.ListStudioSessionMappingsInput) ([]*emr.SessionMappingSummary, error) {
+	var output []*emr.SessionMappingSummary
+
+	err := conn.ListStudioSessionMappingsPages(input, func(page *emr.ListStudioSessionMappingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SessionMappings {
+			if v == nil {
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "Studio does not exist") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -3,6 +3,8 @@ package emr
 import (
 	"fmt"
 	"strings"
+
+	"github.com/aws/aws-sdk-go/service/emr"
 )
 
 func readStudioSessionMapping(id string) (studioId, identityType, identityId string, err error) {
@@ -12,3 +14,19 @@ func readStudioSessionMapping(id string) (studioId, identityType, identityId str
 	}
 	return idParts[0], idParts[1], idParts[2], nil
 }
+
+func StudioSessionMappingsCreateID(studioID, sessionPolicyARN string) string {
+	return fmt.Sprintf("%s:%s:%s", studioID, emr.IdentityTypeGroup, sessionPolicyARN)
+}
+
+// StudioSessionMappingsParseID splits the ID into the studio ID and session policy ARN.
+// The ARN itself contains colons, so only the first two separate the ID's parts.
+func StudioSessionMappingsParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] != emr.IdentityTypeGroup || parts[2] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected studio-id:%[2]s:session-policy-arn", id, emr.IdentityTypeGroup)
+	}
+
+	return parts[0], parts[2], nil
+}
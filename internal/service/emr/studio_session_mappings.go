@@ -0,0 +1,210 @@
+package emr
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceStudioSessionMappings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStudioSessionMappingsCreate,
+		Read:   resourceStudioSessionMappingsRead,
+		Update: resourceStudioSessionMappingsUpdate,
+		Delete: resourceStudioSessionMappingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"session_policy_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"studio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceStudioSessionMappingsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EMRConn
+
+	studioID := d.Get("studio_id").(string)
+	sessionPolicyARN := d.Get("session_policy_arn").(string)
+	id := StudioSessionMappingsCreateID(studioID, sessionPolicyARN)
+
+	for i, groupID := range aws.StringValueSlice(flex.ExpandStringSet(d.Get("group_ids").(*schema.Set))) {
+		err := createStudioSessionMapping(conn, studioID, groupID, sessionPolicyARN)
+
+		if err != nil {
+			// Set the ID so that any mappings already created are removed on destroy.
+			if i > 0 {
+				d.SetId(id)
+			}
+
+			return err
+		}
+	}
+
+	d.SetId(id)
+
+	return resourceStudioSessionMappingsRead(d, meta)
+}
+
+func resourceStudioSessionMappingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EMRConn
+
+	studioID, sessionPolicyARN, err := StudioSessionMappingsParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	mappings, err := FindStudioSessionMappings(conn, &emr.ListStudioSessionMappingsInput{
+		IdentityType: aws.String(emr.IdentityTypeGroup),
+		StudioId:     aws.String(studioID),
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Studio Session Mappings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EMR Studio Session Mappings (%s): %w", d.Id(), err)
+	}
+
+	// Only the groups already in state are managed by this resource, so that mappings
+	// created outside of it are left alone. On import every group mapped to the policy is adopted.
+	groupIDs := d.Get("group_ids").(*schema.Set)
+	var foundGroupIDs []string
+
+	for _, mapping := range mappings {
+		if aws.StringValue(mapping.SessionPolicyArn) != sessionPolicyARN {
+			continue
+		}
+
+		groupID := aws.StringValue(mapping.IdentityId)
+
+		if groupIDs.Len() > 0 && !groupIDs.Contains(groupID) {
+			continue
+		}
+
+		foundGroupIDs = append(foundGroupIDs, groupID)
+	}
+
+	if !d.IsNewResource() && len(foundGroupIDs) == 0 {
+		log.Printf("[WARN] EMR Studio Session Mappings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group_ids", foundGroupIDs)
+	d.Set("session_policy_arn", sessionPolicyARN)
+	d.Set("studio_id", studioID)
+
+	return nil
+}
+
+func resourceStudioSessionMappingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EMRConn
+
+	studioID, sessionPolicyARN, err := StudioSessionMappingsParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("group_ids")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	for _, groupID := range aws.StringValueSlice(flex.ExpandStringSet(os.Difference(ns))) {
+		if err := deleteStudioSessionMapping(conn, studioID, groupID); err != nil {
+			return err
+		}
+	}
+
+	for _, groupID := range aws.StringValueSlice(flex.ExpandStringSet(ns.Difference(os))) {
+		if err := createStudioSessionMapping(conn, studioID, groupID, sessionPolicyARN); err != nil {
+			return err
+		}
+	}
+
+	return resourceStudioSessionMappingsRead(d, meta)
+}
+
+func resourceStudioSessionMappingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EMRConn
+
+	studioID, _, err := StudioSessionMappingsParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	for _, groupID := range aws.StringValueSlice(flex.ExpandStringSet(d.Get("group_ids").(*schema.Set))) {
+		if err := deleteStudioSessionMapping(conn, studioID, groupID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createStudioSessionMapping(conn *emr.EMR, studioID, groupID, sessionPolicyARN string) error {
+	input := &emr.CreateStudioSessionMappingInput{
+		IdentityId:       aws.String(groupID),
+		IdentityType:     aws.String(emr.IdentityTypeGroup),
+		SessionPolicyArn: aws.String(sessionPolicyARN),
+		StudioId:         aws.String(studioID),
+	}
+
+	log.Printf("[DEBUG] Creating EMR Studio Session Mapping: %s", input)
+	_, err := conn.CreateStudioSessionMapping(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EMR Studio (%s) Session Mapping for group (%s): %w", studioID, groupID, err)
+	}
+
+	return nil
+}
+
+func deleteStudioSessionMapping(conn *emr.EMR, studioID, groupID string) error {
+	log.Printf("[INFO] Deleting EMR Studio (%s) Session Mapping for group (%s)", studioID, groupID)
+	_, err := conn.DeleteStudioSessionMapping(&emr.DeleteStudioSessionMappingInput{
+		IdentityId:   aws.String(groupID),
+		IdentityType: aws.String(emr.IdentityTypeGroup),
+		StudioId:     aws.String(studioID),
+	})
+
+	if tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "Studio session mapping does not exist.") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EMR Studio (%s) Session Mapping for group (%s): %w", studioID, groupID, err)
+	}
+
+	return nil
+}
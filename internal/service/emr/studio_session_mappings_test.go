@@ -0,0 +1,207 @@
+package emr_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemr "github.com/hashicorp/terraform-provider-aws/internal/service/emr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEMRStudioSessionMappings_basic(t *testing.T) {
+	resourceName := "aws_emr_studio_session_mappings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	updatedName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	gID := os.Getenv("AWS_IDENTITY_STORE_GROUP_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckGroupID(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, emr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmrStudioSessionMappingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEMRStudioSessionMappingsConfig(rName, gID, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmrStudioSessionMappingsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "group_ids.*", gID),
+					resource.TestCheckResourceAttrPair(resourceName, "studio_id", "aws_emr_studio.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "session_policy_arn", "aws_iam_policy.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEMRStudioSessionMappingsConfigUpdated(rName, gID, updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmrStudioSessionMappingsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "session_policy_arn", "aws_iam_policy.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRStudioSessionMappings_disappears(t *testing.T) {
+	resourceName := "aws_emr_studio_session_mappings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	gID := os.Getenv("AWS_IDENTITY_STORE_GROUP_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckGroupID(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, emr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmrStudioSessionMappingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEMRStudioSessionMappingsConfig(rName, gID, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmrStudioSessionMappingsExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfemr.ResourceStudioSessionMappings(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEmrStudioSessionMappingsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		studioID, _, err := tfemr.StudioSessionMappingsParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn
+
+		for _, groupID := range testAccStudioSessionMappingsGroupIDs(rs) {
+			_, err := tfemr.FindStudioSessionMapping(conn, &emr.GetStudioSessionMappingInput{
+				IdentityId:   aws.String(groupID),
+				IdentityType: aws.String(emr.IdentityTypeGroup),
+				StudioId:     aws.String(studioID),
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEmrStudioSessionMappingsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_emr_studio_session_mappings" {
+			continue
+		}
+
+		studioID, _, err := tfemr.StudioSessionMappingsParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, groupID := range testAccStudioSessionMappingsGroupIDs(rs) {
+			_, err := tfemr.FindStudioSessionMapping(conn, &emr.GetStudioSessionMappingInput{
+				IdentityId:   aws.String(groupID),
+				IdentityType: aws.String(emr.IdentityTypeGroup),
+				StudioId:     aws.String(studioID),
+			})
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EMR Studio Session Mapping %s for group %s still exists", studioID, groupID)
+		}
+	}
+
+	return nil
+}
+
+func testAccStudioSessionMappingsGroupIDs(rs *terraform.ResourceState) []string {
+	var groupIDs []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "group_ids.") && k != "group_ids.#" {
+			groupIDs = append(groupIDs, v)
+		}
+	}
+
+	return groupIDs
+}
+
+func testAccPreCheckGroupID(t *testing.T) {
+	if os.Getenv("AWS_IDENTITY_STORE_GROUP_ID") == "" {
+		t.Skip("AWS_IDENTITY_STORE_GROUP_ID env var must be set for AWS Identity Store Group acceptance test. " +
+			"This is required until ListGroups API returns results without filtering by name.")
+	}
+}
+
+func testAccEMRStudioSessionMappingsConfig(rName, gID, policyName string) string {
+	return acctest.ConfigCompose(testAccEMRStudioSessionMappingConfigBase(rName), fmt.Sprintf(`
+resource "aws_emr_studio_session_mappings" "test" {
+  studio_id          = aws_emr_studio.test.id
+  group_ids          = [%[1]q]
+  session_policy_arn = aws_iam_policy.%[2]s.arn
+}
+`, gID, policyName))
+}
+
+func testAccEMRStudioSessionMappingsConfigUpdated(rName, gID, updatedName string) string {
+	return acctest.ConfigCompose(testAccEMRStudioSessionMappingsConfig(rName, gID, "test2"), fmt.Sprintf(`
+resource "aws_iam_policy" "test2" {
+  name   = %[1]q
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:*"
+      ],
+      "Resource": [
+        "${aws_s3_bucket.test.arn}/*",
+        "${aws_s3_bucket.test.arn}"
+      ]
+    }
+  ]
+}
+EOF
+}
+`, updatedName))
+}
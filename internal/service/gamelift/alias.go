@@ -6,11 +6,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -104,19 +104,17 @@ func resourceAliasRead(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	log.Printf("[INFO] Describing Gamelift Alias: %s", d.Id())
-	out, err := conn.DescribeAlias(&gamelift.DescribeAliasInput{
-		AliasId: aws.String(d.Id()),
-	})
+	a, err := FindAliasByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Gamelift Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	if err != nil {
-		if tfawserr.ErrMessageContains(err, gamelift.ErrCodeNotFoundException, "") {
-			d.SetId("")
-			log.Printf("[WARN] Gamelift Alias (%s) not found, removing from state", d.Id())
-			return nil
-		}
-		return err
+		return fmt.Errorf("error reading Gamelift Alias (%s): %w", d.Id(), err)
 	}
-	a := out.Alias
 
 	arn := aws.StringValue(a.AliasArn)
 	d.Set("arn", arn)
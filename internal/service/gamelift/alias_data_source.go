@@ -0,0 +1,86 @@
+package gamelift
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceAlias() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAliasRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"routing_strategy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fleet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	alias, err := FindAliasByNameAndTags(conn, d.Get("name").(string), tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("GameLift Alias", err)
+	}
+
+	d.SetId(aws.StringValue(alias.AliasId))
+	arn := aws.StringValue(alias.AliasArn)
+	d.Set("arn", arn)
+	d.Set("description", alias.Description)
+	d.Set("name", alias.Name)
+
+	if err := d.Set("routing_strategy", flattenGameliftRoutingStrategy(alias.RoutingStrategy)); err != nil {
+		return fmt.Errorf("error setting routing_strategy: %w", err)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for GameLift Alias (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
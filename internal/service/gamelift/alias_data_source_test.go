@@ -0,0 +1,83 @@
+package gamelift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccGameLiftAliasDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_gamelift_alias.test"
+	resourceName := "aws_gamelift_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, gamelift.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routing_strategy.#", resourceName, "routing_strategy.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routing_strategy.0.type", resourceName, "routing_strategy.0.type"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Deployment", "blue"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAliasDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_alias" "test" {
+  name        = %[1]q
+  description = "blue"
+
+  routing_strategy {
+    message = "blue"
+    type    = "TERMINAL"
+  }
+
+  tags = {
+    Deployment = "blue"
+  }
+}
+
+resource "aws_gamelift_alias" "test2" {
+  name        = %[1]q
+  description = "green"
+
+  routing_strategy {
+    message = "green"
+    type    = "TERMINAL"
+  }
+
+  tags = {
+    Deployment = "green"
+  }
+}
+
+data "aws_gamelift_alias" "test" {
+  name = %[1]q
+
+  tags = {
+    Deployment = "blue"
+  }
+
+  depends_on = [aws_gamelift_alias.test, aws_gamelift_alias.test2]
+}
+`, rName)
+}
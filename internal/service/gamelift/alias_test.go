@@ -5,14 +5,13 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -243,17 +242,11 @@ func testAccCheckAliasExists(n string, res *gamelift.Alias) resource.TestCheckFu
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
 
-		out, err := conn.DescribeAlias(&gamelift.DescribeAliasInput{
-			AliasId: aws.String(rs.Primary.ID),
-		})
+		a, err := tfgamelift.FindAliasByID(conn, rs.Primary.ID)
+
 		if err != nil {
 			return err
 		}
-		a := out.Alias
-
-		if *a.AliasId != rs.Primary.ID {
-			return fmt.Errorf("Gamelift Alias not found")
-		}
 
 		*res = *a
 
@@ -269,18 +262,17 @@ func testAccCheckAliasDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := conn.DescribeAlias(&gamelift.DescribeAliasInput{
-			AliasId: aws.String(rs.Primary.ID),
-		})
-		if err == nil {
-			return fmt.Errorf("Gamelift Alias still exists")
+		_, err := tfgamelift.FindAliasByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
 		}
 
-		if tfawserr.ErrMessageContains(err, gamelift.ErrCodeNotFoundException, "") {
-			return nil
+		if err != nil {
+			return err
 		}
 
-		return err
+		return fmt.Errorf("Gamelift Alias %s still exists", rs.Primary.ID)
 	}
 
 	return nil
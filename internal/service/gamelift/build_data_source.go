@@ -0,0 +1,73 @@
+package gamelift
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceBuild() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBuildRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBuildRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	build, err := FindBuildByNameAndTags(conn, d.Get("name").(string), d.Get("version").(string), tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("GameLift Build", err)
+	}
+
+	d.SetId(aws.StringValue(build.BuildId))
+	arn := aws.StringValue(build.BuildArn)
+	d.Set("arn", arn)
+	d.Set("name", build.Name)
+	d.Set("operating_system", build.OperatingSystem)
+	d.Set("status", build.Status)
+	d.Set("version", build.Version)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for GameLift Build (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -1,19 +1,32 @@
 package gamelift
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func FindBuildByID(conn *gamelift.GameLift, id string) (*gamelift.Build, error) {
-	input := &gamelift.DescribeBuildInput{
-		BuildId: aws.String(id),
-	}
+func FindInstances(conn *gamelift.GameLift, input *gamelift.DescribeInstancesInput) ([]*gamelift.Instance, error) {
+	var output []*gamelift.Instance
+
+	err := conn.DescribeInstancesPages(input, func(page *gamelift.DescribeInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-	output, err := conn.DescribeBuild(input)
+		for _, v := range page.Instances {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
 
 	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
 		return nil, &resource.NotFoundError{
@@ -26,19 +39,16 @@ func FindBuildByID(conn *gamelift.GameLift, id string) (*gamelift.Build, error)
 		return nil, err
 	}
 
-	if output == nil || output.Build == nil {
-		return nil, tfresource.NewEmptyResultError(input)
-	}
-
-	return output.Build, nil
+	return output, nil
 }
 
-func FindFleetByID(conn *gamelift.GameLift, id string) (*gamelift.FleetAttributes, error) {
-	input := &gamelift.DescribeFleetAttributesInput{
-		FleetIds: aws.StringSlice([]string{id}),
+func FindInstanceAccess(conn *gamelift.GameLift, fleetID, instanceID string) (*gamelift.InstanceAccess, error) {
+	input := &gamelift.GetInstanceAccessInput{
+		FleetId:    aws.String(fleetID),
+		InstanceId: aws.String(instanceID),
 	}
 
-	output, err := conn.DescribeFleetAttributes(input)
+	output, err := conn.GetInstanceAccess(input)
 
 	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
 		return nil, &resource.NotFoundError{
@@ -51,19 +61,234 @@ func FindFleetByID(conn *gamelift.GameLift, id string) (*gamelift.FleetAttribute
 		return nil, err
 	}
 
-	if len(output.FleetAttributes) == 0 || output.FleetAttributes[0] == nil {
-		return nil, tfresource.NewEmptyResultError(output)
+	if output == nil || output.InstanceAccess == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.InstanceAccess, nil
+}
+
+// FindAliasByNameAndTags returns the single alias with the specified name and, if any, tags.
+// Alias names are not unique, so tags can be used to narrow down the match.
+func FindAliasByNameAndTags(conn *gamelift.GameLift, name string, tags tftags.KeyValueTags) (*gamelift.Alias, error) {
+	input := &gamelift.ListAliasesInput{
+		Name: aws.String(name),
+	}
+
+	var output []*gamelift.Alias
+
+	err := conn.ListAliasesPages(input, func(page *gamelift.ListAliasesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Aliases {
+			if v != nil && aws.StringValue(v.Name) == name {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) > 0 {
+		var filtered []*gamelift.Alias
+
+		for _, v := range output {
+			ok, err := resourceHasTags(conn, aws.StringValue(v.AliasArn), tags)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				filtered = append(filtered, v)
+			}
+		}
+
+		output = filtered
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	if count := len(output.FleetAttributes); count > 1 {
-		return nil, tfresource.NewTooManyResultsError(count, output)
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
 	}
 
-	fleet := output.FleetAttributes[0]
+	return output[0], nil
+}
+
+// FindBuildByNameAndTags returns the single build with the specified name and, if any, version and tags.
+func FindBuildByNameAndTags(conn *gamelift.GameLift, name, version string, tags tftags.KeyValueTags) (*gamelift.Build, error) {
+	input := &gamelift.ListBuildsInput{}
+
+	var output []*gamelift.Build
+
+	err := conn.ListBuildsPages(input, func(page *gamelift.ListBuildsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Builds {
+			if v == nil || aws.StringValue(v.Name) != name {
+				continue
+			}
+
+			if version != "" && aws.StringValue(v.Version) != version {
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) > 0 {
+		var filtered []*gamelift.Build
+
+		for _, v := range output {
+			ok, err := resourceHasTags(conn, aws.StringValue(v.BuildArn), tags)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				filtered = append(filtered, v)
+			}
+		}
+
+		output = filtered
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
 
-	if aws.StringValue(fleet.FleetId) != id {
-		return nil, tfresource.NewEmptyResultError(id)
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// FindFleetByNameAndTags returns the single fleet with the specified name and, if any, status and tags.
+// This allows e.g. the live fleet of a blue/green deployment to be looked up by a tag that marks it as such.
+func FindFleetByNameAndTags(conn *gamelift.GameLift, name, status string, tags tftags.KeyValueTags) (*gamelift.FleetAttributes, error) {
+	input := &gamelift.DescribeFleetAttributesInput{}
+
+	var output []*gamelift.FleetAttributes
+
+	err := conn.DescribeFleetAttributesPages(input, func(page *gamelift.DescribeFleetAttributesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FleetAttributes {
+			if v == nil || aws.StringValue(v.Name) != name {
+				continue
+			}
+
+			if status != "" && aws.StringValue(v.Status) != status {
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) > 0 {
+		var filtered []*gamelift.FleetAttributes
+
+		for _, v := range output {
+			ok, err := resourceHasTags(conn, aws.StringValue(v.FleetArn), tags)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				filtered = append(filtered, v)
+			}
+		}
+
+		output = filtered
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func resourceHasTags(conn *gamelift.GameLift, arn string, tags tftags.KeyValueTags) (bool, error) {
+	resourceTags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return false, fmt.Errorf("error listing tags for GameLift resource (%s): %w", arn, err)
+	}
+
+	return resourceTags.ContainsAll(tags), nil
+}
+
+func FindScalingPolicyByFleetIDAndName(conn *gamelift.GameLift, fleetID, name string) (*gamelift.ScalingPolicy, error) {
+	input := &gamelift.DescribeScalingPoliciesInput{
+		FleetId: aws.String(fleetID),
+	}
+
+	var policy *gamelift.ScalingPolicy
+
+	err := conn.DescribeScalingPoliciesPages(input, func(page *gamelift.DescribeScalingPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ScalingPolicies {
+			if v != nil && aws.StringValue(v.Name) == name {
+				policy = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil || aws.StringValue(policy.Status) == gamelift.ScalingStatusTypeDeleted {
+		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return fleet, nil
+	return policy, nil
 }
@@ -0,0 +1,131 @@
+// Code generated by "internal/generate/finders/main.go -NotFoundErrCode=ErrCodeNotFoundException -Finder=Name=Alias,By=ID,Op=DescribeAlias,InputField=AliasId,OutputField=Alias -Finder=Name=Build,By=ID,Op=DescribeBuild,InputField=BuildId,OutputField=Build -Finder=Name=Fleet,By=ID,Op=DescribeFleetAttributes,InputField=FleetIds,OutputField=FleetAttributes,MatchField=FleetId -Finder=Name=GameSessionQueue,By=Name,Op=DescribeGameSessionQueues,InputField=Names,OutputField=GameSessionQueues,MatchField=Name"; DO NOT EDIT.
+
+package gamelift
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAliasByID(conn *gamelift.GameLift, id string) (*gamelift.Alias, error) {
+	input := &gamelift.DescribeAliasInput{
+		AliasId: aws.String(id),
+	}
+
+	output, err := conn.DescribeAlias(input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Alias == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Alias, nil
+}
+
+func FindBuildByID(conn *gamelift.GameLift, id string) (*gamelift.Build, error) {
+	input := &gamelift.DescribeBuildInput{
+		BuildId: aws.String(id),
+	}
+
+	output, err := conn.DescribeBuild(input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Build == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Build, nil
+}
+
+func FindFleetByID(conn *gamelift.GameLift, id string) (*gamelift.FleetAttributes, error) {
+	input := &gamelift.DescribeFleetAttributesInput{
+		FleetIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeFleetAttributes(input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.FleetAttributes) == 0 || output.FleetAttributes[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.FleetAttributes); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	if v := output.FleetAttributes[0]; aws.StringValue(v.FleetId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output.FleetAttributes[0], nil
+}
+
+func FindGameSessionQueueByName(conn *gamelift.GameLift, name string) (*gamelift.GameSessionQueue, error) {
+	input := &gamelift.DescribeGameSessionQueuesInput{
+		Names: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeGameSessionQueues(input)
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.GameSessionQueues) == 0 || output.GameSessionQueues[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.GameSessionQueues); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	if v := output.GameSessionQueues[0]; aws.StringValue(v.Name) != name {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output.GameSessionQueues[0], nil
+}
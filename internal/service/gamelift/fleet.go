@@ -3,7 +3,6 @@ package gamelift
 import (
 	"fmt"
 	"log"
-	"reflect"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -23,6 +22,12 @@ import (
 const (
 	fleetCreatedDefaultTimeout = 70 * time.Minute
 	FleetDeletedDefaultTimeout = 20 * time.Minute
+
+	// Deletion is retried at a fixed interval so that abandon_delete_after_attempts maps to a predictable duration.
+	fleetDeleteRetryInterval = 30 * time.Second
+
+	// UpdateFleetPortSettings accepts at most 50 authorizations and 50 revocations per call.
+	portSettingsBatchSize = 50
 )
 
 func ResourceFleet() *schema.Resource {
@@ -40,6 +45,11 @@ func ResourceFleet() *schema.Resource {
 			Delete: schema.DefaultTimeout(FleetDeletedDefaultTimeout),
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			tfresource.RenameAttributesStateUpgrader(0, resourceFleetV0(), map[string]string{"ec2_inbound_permission.ip_range": "cidr_block"}),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -82,16 +92,16 @@ func ResourceFleet() *schema.Resource {
 				MaxItems: 50,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"cidr_block": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
 						"from_port": {
 							Type:         schema.TypeInt,
 							Required:     true,
 							ValidateFunc: validation.IsPortNumber,
 						},
-						"ip_range": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidCIDRNetworkAddress,
-						},
 						"protocol": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -399,13 +409,15 @@ func resourceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
 		oldPerms, newPerms := d.GetChange("ec2_inbound_permission")
 		authorizations, revocations := DiffPortSettings(oldPerms.(*schema.Set).List(), newPerms.(*schema.Set).List())
 
-		_, err := conn.UpdateFleetPortSettings(&gamelift.UpdateFleetPortSettingsInput{
-			FleetId:                         aws.String(d.Id()),
-			InboundPermissionAuthorizations: authorizations,
-			InboundPermissionRevocations:    revocations,
-		})
-		if err != nil {
-			return fmt.Errorf("error updating for GameLift Fleet port settings (%s): %w", d.Id(), err)
+		for _, batch := range BatchPortSettings(authorizations, revocations, portSettingsBatchSize) {
+			_, err := conn.UpdateFleetPortSettings(&gamelift.UpdateFleetPortSettingsInput{
+				FleetId:                         aws.String(d.Id()),
+				InboundPermissionAuthorizations: batch.Authorizations,
+				InboundPermissionRevocations:    batch.Revocations,
+			})
+			if err != nil {
+				return fmt.Errorf("error updating for GameLift Fleet port settings (%s): %w", d.Id(), err)
+			}
 		}
 	}
 
@@ -437,27 +449,34 @@ func resourceFleetDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Deleting Gamelift Fleet: %s", d.Id())
 	// It can take ~ 1 hr as Gamelift will keep retrying on errors like
 	// invalid launch path and remain in state when it can't be deleted :/
+	// The provider's abandon_delete_after_attempts setting caps the number of attempts.
 	input := &gamelift.DeleteFleetInput{
 		FleetId: aws.String(d.Id()),
 	}
-	err := resource.Retry(60*time.Minute, func() *resource.RetryError {
-		_, err := conn.DeleteFleet(input)
-		if err != nil {
+	_, err := tfresource.RetryWhenAbandonAfter(60*time.Minute, fleetDeleteRetryInterval, meta.(*conns.AWSClient).AbandonDeleteAfterAttempts,
+		func() (interface{}, error) {
+			return conn.DeleteFleet(input)
+		},
+		func(err error) (bool, error) {
 			msg := fmt.Sprintf("Cannot delete fleet %s that is in status of ", d.Id())
 			if tfawserr.ErrMessageContains(err, gamelift.ErrCodeInvalidRequestException, msg) {
-				return resource.RetryableError(err)
+				return true, err
 			}
-			return resource.NonRetryableError(err)
-		}
+
+			return false, err
+		},
+	)
+
+	if tfresource.Abandoned(err) {
+		log.Printf("[WARN] Abandoning Gamelift Fleet (%s) deletion, removing from state: %s", d.Id(), err)
 		return nil
-	})
-	if tfresource.TimedOut(err) {
-		_, err = conn.DeleteFleet(input)
 	}
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil
+	}
+
 	if err != nil {
-		if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
-			return nil
-		}
 		return fmt.Errorf("Error deleting Gamelift fleet: %w", err)
 	}
 
@@ -484,7 +503,7 @@ func expandGameliftIpPermissions(cfgs *schema.Set) []*gamelift.IpPermission {
 func expandGameliftIpPermission(cfg map[string]interface{}) *gamelift.IpPermission {
 	return &gamelift.IpPermission{
 		FromPort: aws.Int64(int64(cfg["from_port"].(int))),
-		IpRange:  aws.String(cfg["ip_range"].(string)),
+		IpRange:  aws.String(cfg["cidr_block"].(string)),
 		Protocol: aws.String(cfg["protocol"].(string)),
 		ToPort:   aws.Int64(int64(cfg["to_port"].(int))),
 	}
@@ -519,7 +538,7 @@ func flattenGameliftIpPermission(apiObject *gamelift.IpPermission) map[string]in
 	tfMap["from_port"] = aws.Int64Value(apiObject.FromPort)
 	tfMap["to_port"] = aws.Int64Value(apiObject.ToPort)
 	tfMap["protocol"] = aws.StringValue(apiObject.Protocol)
-	tfMap["ip_range"] = aws.StringValue(apiObject.IpRange)
+	tfMap["cidr_block"] = aws.StringValue(apiObject.IpRange)
 
 	return tfMap
 }
@@ -619,29 +638,77 @@ func flattenGameliftCertificateConfiguration(config *gamelift.CertificateConfigu
 }
 
 func DiffPortSettings(oldPerms, newPerms []interface{}) (a []*gamelift.IpPermission, r []*gamelift.IpPermission) {
-OUTER:
-	for i, op := range oldPerms {
+	oldKeys := make(map[string]struct{}, len(oldPerms))
+	for _, op := range oldPerms {
+		oldKeys[ipPermissionKey(op.(map[string]interface{}))] = struct{}{}
+	}
+
+	newKeys := make(map[string]struct{}, len(newPerms))
+	for _, np := range newPerms {
+		newKeys[ipPermissionKey(np.(map[string]interface{}))] = struct{}{}
+	}
+
+	// Permissions present on both sides are left alone so that we don't waste
+	// API calls on the removal and subsequent addition of the same ones.
+	for _, op := range oldPerms {
 		oldPerm := op.(map[string]interface{})
-		for j, np := range newPerms {
-			newPerm := np.(map[string]interface{})
-
-			// Remove permissions which have not changed so we're not wasting
-			// API calls for removal & subseq. addition of the same ones
-			if reflect.DeepEqual(oldPerm, newPerm) {
-				oldPerms = append(oldPerms[:i], oldPerms[i+1:]...)
-				newPerms = append(newPerms[:j], newPerms[j+1:]...)
-				continue OUTER
-			}
+		if _, ok := newKeys[ipPermissionKey(oldPerm)]; !ok {
+			r = append(r, expandGameliftIpPermission(oldPerm))
 		}
-
-		// Add what's left for revocation
-		r = append(r, expandGameliftIpPermission(oldPerm))
 	}
 
 	for _, np := range newPerms {
 		newPerm := np.(map[string]interface{})
-		// Add what's left for authorization
-		a = append(a, expandGameliftIpPermission(newPerm))
+		if _, ok := oldKeys[ipPermissionKey(newPerm)]; !ok {
+			a = append(a, expandGameliftIpPermission(newPerm))
+		}
 	}
+
 	return
 }
+
+// PortSettingsBatch is the set of changes sent in a single UpdateFleetPortSettings call.
+type PortSettingsBatch struct {
+	Authorizations []*gamelift.IpPermission
+	Revocations    []*gamelift.IpPermission
+}
+
+// BatchPortSettings splits authorizations and revocations into batches of at most size changes each.
+// Revocations are sent first so that a port range can be replaced without exceeding the fleet's permission limit.
+func BatchPortSettings(authorizations, revocations []*gamelift.IpPermission, size int) []PortSettingsBatch {
+	var batches []PortSettingsBatch
+	var batch PortSettingsBatch
+	n := 0
+
+	flush := func() {
+		if n > 0 {
+			batches = append(batches, batch)
+		}
+		batch = PortSettingsBatch{}
+		n = 0
+	}
+
+	for _, v := range revocations {
+		if n == size {
+			flush()
+		}
+		batch.Revocations = append(batch.Revocations, v)
+		n++
+	}
+
+	for _, v := range authorizations {
+		if n == size {
+			flush()
+		}
+		batch.Authorizations = append(batch.Authorizations, v)
+		n++
+	}
+
+	flush()
+
+	return batches
+}
+
+func ipPermissionKey(m map[string]interface{}) string {
+	return fmt.Sprintf("%v:%v:%v:%v", m["protocol"], m["cidr_block"], m["from_port"], m["to_port"])
+}
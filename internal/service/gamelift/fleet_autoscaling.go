@@ -0,0 +1,359 @@
+package gamelift
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	fleetAutoscalingAlarmNamespace = "AWS/GameLift"
+	fleetAutoscalingIDSeparator    = "/"
+)
+
+// ResourceFleetAutoscaling manages a target-based scaling policy on PercentAvailableGameSessions
+// together with an optional CloudWatch alarm on the same metric, with the fleet's dimensions filled in.
+func ResourceFleetAutoscaling() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFleetAutoscalingPut,
+		Read:   resourceFleetAutoscalingRead,
+		Update: resourceFleetAutoscalingPut,
+		Delete: resourceFleetAutoscalingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alarm": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_actions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comparison_operator": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      cloudwatch.ComparisonOperatorLessThanThreshold,
+							ValidateFunc: validation.StringInSlice(cloudwatch.ComparisonOperator_Values(), false),
+						},
+						"evaluation_periods": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"ok_actions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntAtLeast(10),
+						},
+						"threshold": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+					},
+				},
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_percent_available_game_sessions": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0, 100),
+			},
+		},
+	}
+}
+
+func resourceFleetAutoscalingPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+	cloudwatchConn := meta.(*conns.AWSClient).CloudWatchConn
+
+	fleetID := d.Get("fleet_id").(string)
+	name := d.Get("name").(string)
+	id := FleetAutoscalingCreateResourceID(fleetID, name)
+
+	input := &gamelift.PutScalingPolicyInput{
+		FleetId:    aws.String(fleetID),
+		MetricName: aws.String(gamelift.MetricNamePercentAvailableGameSessions),
+		Name:       aws.String(name),
+		PolicyType: aws.String(gamelift.PolicyTypeTargetBased),
+		TargetConfiguration: &gamelift.TargetConfiguration{
+			TargetValue: aws.Float64(d.Get("target_percent_available_game_sessions").(float64)),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting GameLift Fleet Autoscaling scaling policy: %s", input)
+	if _, err := conn.PutScalingPolicy(input); err != nil {
+		return fmt.Errorf("error putting GameLift Fleet Autoscaling (%s) scaling policy: %w", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	if d.HasChange("alarm") {
+		o, n := d.GetChange("alarm")
+
+		// Remove an alarm that is no longer configured or has been renamed.
+		if oldName := fleetAutoscalingAlarmName(o.([]interface{})); oldName != "" && oldName != fleetAutoscalingAlarmName(n.([]interface{})) {
+			if err := deleteFleetAutoscalingAlarm(cloudwatchConn, oldName); err != nil {
+				return err
+			}
+		}
+
+		if v := n.([]interface{}); len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			input := expandFleetAutoscalingAlarm(tfMap, fleetID, name)
+
+			log.Printf("[DEBUG] Putting GameLift Fleet Autoscaling alarm: %s", input)
+			if _, err := cloudwatchConn.PutMetricAlarm(input); err != nil {
+				return fmt.Errorf("error putting GameLift Fleet Autoscaling (%s) CloudWatch alarm: %w", id, err)
+			}
+
+			// Record the alarm's name, which may have been generated, so that Read can find it.
+			tfMap["name"] = aws.StringValue(input.AlarmName)
+			if err := d.Set("alarm", []interface{}{tfMap}); err != nil {
+				return fmt.Errorf("error setting alarm: %w", err)
+			}
+		}
+	}
+
+	return resourceFleetAutoscalingRead(d, meta)
+}
+
+func resourceFleetAutoscalingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+	cloudwatchConn := meta.(*conns.AWSClient).CloudWatchConn
+
+	fleetID, name, err := FleetAutoscalingParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	policy, err := FindScalingPolicyByFleetIDAndName(conn, fleetID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Fleet Autoscaling (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading GameLift Fleet Autoscaling (%s) scaling policy: %w", d.Id(), err)
+	}
+
+	d.Set("fleet_id", policy.FleetId)
+	d.Set("name", policy.Name)
+	d.Set("status", policy.Status)
+
+	if v := policy.TargetConfiguration; v != nil {
+		d.Set("target_percent_available_game_sessions", v.TargetValue)
+	}
+
+	alarms := d.Get("alarm").([]interface{})
+
+	if alarmName := fleetAutoscalingAlarmName(alarms); alarmName != "" {
+		alarm, err := tfcloudwatch.FindMetricAlarmByName(cloudwatchConn, alarmName)
+
+		if err != nil {
+			return fmt.Errorf("error reading GameLift Fleet Autoscaling (%s) CloudWatch alarm (%s): %w", d.Id(), alarmName, err)
+		}
+
+		if alarm == nil {
+			log.Printf("[WARN] GameLift Fleet Autoscaling (%s) CloudWatch alarm (%s) not found", d.Id(), alarmName)
+			alarms = nil
+		} else {
+			alarms = []interface{}{flattenFleetAutoscalingAlarm(alarm)}
+		}
+	}
+
+	if err := d.Set("alarm", alarms); err != nil {
+		return fmt.Errorf("error setting alarm: %w", err)
+	}
+
+	return nil
+}
+
+func resourceFleetAutoscalingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+	cloudwatchConn := meta.(*conns.AWSClient).CloudWatchConn
+
+	if alarmName := fleetAutoscalingAlarmName(d.Get("alarm").([]interface{})); alarmName != "" {
+		if err := deleteFleetAutoscalingAlarm(cloudwatchConn, alarmName); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Deleting GameLift Fleet Autoscaling: %s", d.Id())
+	_, err := conn.DeleteScalingPolicy(&gamelift.DeleteScalingPolicyInput{
+		FleetId: aws.String(d.Get("fleet_id").(string)),
+		Name:    aws.String(d.Get("name").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting GameLift Fleet Autoscaling (%s) scaling policy: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FleetAutoscalingCreateResourceID(fleetID, name string) string {
+	parts := []string{fleetID, name}
+	id := strings.Join(parts, fleetAutoscalingIDSeparator)
+
+	return id
+}
+
+func FleetAutoscalingParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, fleetAutoscalingIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FLEET-ID%[2]sNAME", id, fleetAutoscalingIDSeparator)
+}
+
+func deleteFleetAutoscalingAlarm(conn *cloudwatch.CloudWatch, name string) error {
+	log.Printf("[INFO] Deleting GameLift Fleet Autoscaling CloudWatch alarm: %s", name)
+	_, err := conn.DeleteAlarms(&cloudwatch.DeleteAlarmsInput{
+		AlarmNames: aws.StringSlice([]string{name}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatch.ErrCodeResourceNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting GameLift Fleet Autoscaling CloudWatch alarm (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+// fleetAutoscalingAlarmName returns the name of the configured alarm, or "" if there is none.
+func fleetAutoscalingAlarmName(tfList []interface{}) string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return ""
+	}
+
+	if v, ok := tfList[0].(map[string]interface{})["name"].(string); ok {
+		return v
+	}
+
+	return ""
+}
+
+func expandFleetAutoscalingAlarm(tfMap map[string]interface{}, fleetID, policyName string) *cloudwatch.PutMetricAlarmInput {
+	name, _ := tfMap["name"].(string)
+
+	if name == "" {
+		name = fmt.Sprintf("%s-%s-%s", fleetID, policyName, gamelift.MetricNamePercentAvailableGameSessions)
+	}
+
+	input := &cloudwatch.PutMetricAlarmInput{
+		AlarmName:          aws.String(name),
+		ComparisonOperator: aws.String(tfMap["comparison_operator"].(string)),
+		Dimensions: []*cloudwatch.Dimension{
+			{
+				Name:  aws.String("FleetId"),
+				Value: aws.String(fleetID),
+			},
+		},
+		EvaluationPeriods: aws.Int64(int64(tfMap["evaluation_periods"].(int))),
+		MetricName:        aws.String(gamelift.MetricNamePercentAvailableGameSessions),
+		Namespace:         aws.String(fleetAutoscalingAlarmNamespace),
+		Period:            aws.Int64(int64(tfMap["period"].(int))),
+		Statistic:         aws.String(cloudwatch.StatisticAverage),
+		Threshold:         aws.Float64(tfMap["threshold"].(float64)),
+	}
+
+	if v, ok := tfMap["alarm_actions"].(*schema.Set); ok && v.Len() > 0 {
+		input.AlarmActions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["ok_actions"].(*schema.Set); ok && v.Len() > 0 {
+		input.OKActions = flex.ExpandStringSet(v)
+	}
+
+	return input
+}
+
+func flattenFleetAutoscalingAlarm(apiObject *cloudwatch.MetricAlarm) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"alarm_actions":       flex.FlattenStringSet(apiObject.AlarmActions),
+		"arn":                 aws.StringValue(apiObject.AlarmArn),
+		"comparison_operator": aws.StringValue(apiObject.ComparisonOperator),
+		"evaluation_periods":  int(aws.Int64Value(apiObject.EvaluationPeriods)),
+		"name":                aws.StringValue(apiObject.AlarmName),
+		"ok_actions":          flex.FlattenStringSet(apiObject.OKActions),
+		"period":              int(aws.Int64Value(apiObject.Period)),
+		"threshold":           aws.Float64Value(apiObject.Threshold),
+	}
+}
@@ -0,0 +1,170 @@
+package gamelift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGameLiftFleetAutoscaling_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_fleet_autoscaling.test"
+
+	region := acctest.Region()
+	g, err := testAccSampleGame(region)
+
+	if tfresource.NotFound(err) {
+		t.Skip(err)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc := g.Location
+	bucketName := *loc.Bucket
+	roleArn := *loc.RoleArn
+	key := *loc.Key
+	launchPath := g.LaunchPath
+	params := g.Parameters(33435)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, gamelift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFleetAutoscalingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetAutoscalingConfig(rName, launchPath, params, bucketName, key, roleArn, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetAutoscalingExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_id", "aws_gamelift_fleet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "target_percent_available_game_sessions", "20"),
+					resource.TestCheckResourceAttr(resourceName, "alarm.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetAutoscalingAlarmConfig(rName, launchPath, params, bucketName, key, roleArn, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetAutoscalingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_percent_available_game_sessions", "30"),
+					resource.TestCheckResourceAttr(resourceName, "alarm.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "alarm.0.arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "alarm.0.name"),
+					resource.TestCheckResourceAttr(resourceName, "alarm.0.comparison_operator", "LessThanThreshold"),
+					resource.TestCheckResourceAttr(resourceName, "alarm.0.threshold", "5"),
+					resource.TestCheckResourceAttr(resourceName, "alarm.0.alarm_actions.#", "1"),
+				),
+			},
+			{
+				Config: testAccFleetAutoscalingConfig(rName, launchPath, params, bucketName, key, roleArn, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetAutoscalingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFleetAutoscalingExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GameLift Fleet Autoscaling ID is set")
+		}
+
+		fleetID, name, err := tfgamelift.FleetAutoscalingParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+
+		_, err = tfgamelift.FindScalingPolicyByFleetIDAndName(conn, fleetID, name)
+
+		return err
+	}
+}
+
+func testAccCheckFleetAutoscalingDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_gamelift_fleet_autoscaling" {
+			continue
+		}
+
+		fleetID, name, err := tfgamelift.FleetAutoscalingParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfgamelift.FindScalingPolicyByFleetIDAndName(conn, fleetID, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("GameLift Fleet Autoscaling %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFleetAutoscalingConfig(rName, launchPath, params, bucketName, key, roleArn string, target int) string {
+	return testAccFleetBasicConfig(rName, launchPath, params, bucketName, key, roleArn) + fmt.Sprintf(`
+resource "aws_gamelift_fleet_autoscaling" "test" {
+  fleet_id                               = aws_gamelift_fleet.test.id
+  name                                   = %[1]q
+  target_percent_available_game_sessions = %[2]d
+}
+`, rName, target)
+}
+
+func testAccFleetAutoscalingAlarmConfig(rName, launchPath, params, bucketName, key, roleArn string, target int) string {
+	return testAccFleetBasicConfig(rName, launchPath, params, bucketName, key, roleArn) + fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_gamelift_fleet_autoscaling" "test" {
+  fleet_id                               = aws_gamelift_fleet.test.id
+  name                                   = %[1]q
+  target_percent_available_game_sessions = %[2]d
+
+  alarm {
+    threshold     = 5
+    alarm_actions = [aws_sns_topic.test.arn]
+  }
+}
+`, rName, target)
+}
@@ -0,0 +1,111 @@
+package gamelift
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceFleet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFleetRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"build_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"build_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ec2_instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fleet_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"operating_system": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"script_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"script_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.FleetStatus_Values(), false),
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceFleetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	fleet, err := FindFleetByNameAndTags(conn, d.Get("name").(string), d.Get("status").(string), tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("GameLift Fleet", err)
+	}
+
+	d.SetId(aws.StringValue(fleet.FleetId))
+	arn := aws.StringValue(fleet.FleetArn)
+	d.Set("arn", arn)
+	d.Set("build_arn", fleet.BuildArn)
+	d.Set("build_id", fleet.BuildId)
+	d.Set("description", fleet.Description)
+	d.Set("ec2_instance_type", fleet.InstanceType)
+	d.Set("fleet_type", fleet.FleetType)
+	d.Set("instance_role_arn", fleet.InstanceRoleArn)
+	d.Set("name", fleet.Name)
+	d.Set("operating_system", fleet.OperatingSystem)
+	d.Set("script_arn", fleet.ScriptArn)
+	d.Set("script_id", fleet.ScriptId)
+	d.Set("status", fleet.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for GameLift Fleet (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package gamelift_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGameLiftFleetDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	region := acctest.Region()
+	g, err := testAccSampleGame(region)
+
+	if tfresource.NotFound(err) {
+		t.Skip(err)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc := g.Location
+	bucketName := *loc.Bucket
+	roleArn := *loc.RoleArn
+	key := *loc.Key
+	launchPath := g.LaunchPath
+	params := g.Parameters(33435)
+
+	dataSourceName := "data.aws_gamelift_fleet.test"
+	buildDataSourceName := "data.aws_gamelift_build.test"
+	fleetResourceName := "aws_gamelift_fleet.test"
+	buildResourceName := "aws_gamelift_build.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, gamelift.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetDataSourceConfig(rName, launchPath, params, bucketName, key, roleArn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", fleetResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", fleetResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "build_id", fleetResourceName, "build_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ec2_instance_type", fleetResourceName, "ec2_instance_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fleet_type", fleetResourceName, "fleet_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", gamelift.FleetStatusActive),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttrPair(buildDataSourceName, "id", buildResourceName, "id"),
+					resource.TestCheckResourceAttrPair(buildDataSourceName, "arn", buildResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(buildDataSourceName, "operating_system", buildResourceName, "operating_system"),
+					resource.TestCheckResourceAttr(buildDataSourceName, "status", gamelift.BuildStatusReady),
+				),
+			},
+		},
+	})
+}
+
+func testAccFleetDataSourceConfig(rName, launchPath, params, bucketName, key, roleArn string) string {
+	return testAccFleetBasicTags1Config(rName, launchPath, params, bucketName, key, roleArn, "Deployment", "blue") + `
+data "aws_gamelift_build" "test" {
+  name = aws_gamelift_build.test.name
+}
+
+data "aws_gamelift_fleet" "test" {
+  name   = aws_gamelift_fleet.test.name
+  status = "ACTIVE"
+
+  tags = {
+    Deployment = "blue"
+  }
+}
+`
+}
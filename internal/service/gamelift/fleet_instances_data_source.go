@@ -0,0 +1,215 @@
+package gamelift
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceFleetInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFleetInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"include_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"secret": {
+										Type:      schema.TypeString,
+										Computed:  true,
+										Sensitive: true,
+									},
+									"user_name": {
+										Type:      schema.TypeString,
+										Computed:  true,
+										Sensitive: true,
+									},
+								},
+							},
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fleet_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operating_system": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceFleetInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+
+	fleetID := d.Get("fleet_id").(string)
+	input := &gamelift.DescribeInstancesInput{
+		FleetId: aws.String(fleetID),
+	}
+
+	if v, ok := d.GetOk("instance_id"); ok {
+		input.InstanceId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("location"); ok {
+		input.Location = aws.String(v.(string))
+	}
+
+	instances, err := FindInstances(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading GameLift Fleet (%s) instances: %w", fleetID, err)
+	}
+
+	tfList := make([]interface{}, 0, len(instances))
+
+	for _, instance := range instances {
+		tfMap := flattenInstance(instance)
+
+		// Credentials can only be retrieved for instances that are running.
+		if d.Get("include_access").(bool) && aws.StringValue(instance.Status) == gamelift.InstanceStatusActive {
+			instanceID := aws.StringValue(instance.InstanceId)
+			access, err := FindInstanceAccess(conn, fleetID, instanceID)
+
+			if err != nil {
+				return fmt.Errorf("error reading GameLift Fleet (%s) instance (%s) access: %w", fleetID, instanceID, err)
+			}
+
+			if v := access.Credentials; v != nil {
+				tfMap["access"] = []interface{}{flattenInstanceCredentials(v)}
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(fleetID)
+	if err := d.Set("instances", tfList); err != nil {
+		return fmt.Errorf("error setting instances: %w", err)
+	}
+
+	return nil
+}
+
+func flattenInstance(apiObject *gamelift.Instance) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CreationTime; v != nil {
+		tfMap["creation_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.DnsName; v != nil {
+		tfMap["dns_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.FleetArn; v != nil {
+		tfMap["fleet_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.InstanceId; v != nil {
+		tfMap["instance_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.IpAddress; v != nil {
+		tfMap["ip_address"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Location; v != nil {
+		tfMap["location"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OperatingSystem; v != nil {
+		tfMap["operating_system"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Status; v != nil {
+		tfMap["status"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenInstanceCredentials(apiObject *gamelift.InstanceCredentials) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Secret; v != nil {
+		tfMap["secret"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UserName; v != nil {
+		tfMap["user_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,80 @@
+package gamelift_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGameLiftFleetInstancesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	region := acctest.Region()
+	g, err := testAccSampleGame(region)
+
+	if tfresource.NotFound(err) {
+		t.Skip(err)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc := g.Location
+	bucketName := *loc.Bucket
+	roleArn := *loc.RoleArn
+	key := *loc.Key
+	launchPath := g.LaunchPath
+	params := g.Parameters(33435)
+
+	dataSourceName := "data.aws_gamelift_fleet_instances.test"
+	fleetResourceName := "aws_gamelift_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, gamelift.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetInstancesDataSourceConfig(rName, launchPath, params, bucketName, key, roleArn, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "fleet_id", fleetResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.access.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.fleet_arn", fleetResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.instance_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.ip_address"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.status", "ACTIVE"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.type", "c4.large"),
+				),
+			},
+			{
+				Config: testAccFleetInstancesDataSourceConfig(rName, launchPath, params, bucketName, key, roleArn, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.access.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.access.0.secret"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.access.0.user_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFleetInstancesDataSourceConfig(rName, launchPath, params, bucketName, key, roleArn string, includeAccess bool) string {
+	return testAccFleetBasicConfig(rName, launchPath, params, bucketName, key, roleArn) + fmt.Sprintf(`
+data "aws_gamelift_fleet_instances" "test" {
+  fleet_id       = aws_gamelift_fleet.test.id
+  include_access = %[1]t
+}
+`, includeAccess)
+}
@@ -0,0 +1,65 @@
+package gamelift_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+)
+
+func testResourceFleetStateDataV0() map[string]interface{} {
+	return map[string]interface{}{
+		"id":   "fleet-12345678-1234-1234-1234-123456789012",
+		"name": "test",
+		"ec2_inbound_permission": []interface{}{
+			map[string]interface{}{
+				"from_port": 8080,
+				"ip_range":  "8.8.8.8/32",
+				"protocol":  "TCP",
+				"to_port":   8080,
+			},
+			map[string]interface{}{
+				"from_port": 60000,
+				"ip_range":  "8.8.0.0/16",
+				"protocol":  "UDP",
+				"to_port":   60000,
+			},
+		},
+	}
+}
+
+func testResourceFleetStateDataV1() map[string]interface{} {
+	v0 := testResourceFleetStateDataV0()
+	permissions := v0["ec2_inbound_permission"].([]interface{})
+	return map[string]interface{}{
+		"id":   v0["id"],
+		"name": v0["name"],
+		"ec2_inbound_permission": []interface{}{
+			map[string]interface{}{
+				"cidr_block": permissions[0].(map[string]interface{})["ip_range"],
+				"from_port":  8080,
+				"protocol":   "TCP",
+				"to_port":    8080,
+			},
+			map[string]interface{}{
+				"cidr_block": permissions[1].(map[string]interface{})["ip_range"],
+				"from_port":  60000,
+				"protocol":   "UDP",
+				"to_port":    60000,
+			},
+		},
+	}
+}
+
+func TestFleetStateUpgradeV0(t *testing.T) {
+	expected := testResourceFleetStateDataV1()
+	actual, err := tfgamelift.ResourceFleet().StateUpgraders[0].Upgrade(context.Background(), testResourceFleetStateDataV0(), nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
+	}
+}
@@ -26,18 +26,18 @@ func TestDiffGameliftPortSettings(t *testing.T) {
 		{ // No change
 			Old: []interface{}{
 				map[string]interface{}{
-					"from_port": 8443,
-					"ip_range":  "192.168.0.0/24",
-					"protocol":  "TCP",
-					"to_port":   8443,
+					"from_port":  8443,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8443,
 				},
 			},
 			New: []interface{}{
 				map[string]interface{}{
-					"from_port": 8443,
-					"ip_range":  "192.168.0.0/24",
-					"protocol":  "TCP",
-					"to_port":   8443,
+					"from_port":  8443,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8443,
 				},
 			},
 			ExpectedAuths: []*gamelift.IpPermission{},
@@ -46,24 +46,24 @@ func TestDiffGameliftPortSettings(t *testing.T) {
 		{ // Addition
 			Old: []interface{}{
 				map[string]interface{}{
-					"from_port": 8443,
-					"ip_range":  "192.168.0.0/24",
-					"protocol":  "TCP",
-					"to_port":   8443,
+					"from_port":  8443,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8443,
 				},
 			},
 			New: []interface{}{
 				map[string]interface{}{
-					"from_port": 8443,
-					"ip_range":  "192.168.0.0/24",
-					"protocol":  "TCP",
-					"to_port":   8443,
+					"from_port":  8443,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8443,
 				},
 				map[string]interface{}{
-					"from_port": 8888,
-					"ip_range":  "192.168.0.0/24",
-					"protocol":  "TCP",
-					"to_port":   8888,
+					"from_port":  8888,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8888,
 				},
 			},
 			ExpectedAuths: []*gamelift.IpPermission{
@@ -79,10 +79,10 @@ func TestDiffGameliftPortSettings(t *testing.T) {
 		{ // Removal
 			Old: []interface{}{
 				map[string]interface{}{
-					"from_port": 8443,
-					"ip_range":  "192.168.0.0/24",
-					"protocol":  "TCP",
-					"to_port":   8443,
+					"from_port":  8443,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8443,
 				},
 			},
 			New:           []interface{}{},
@@ -99,18 +99,18 @@ func TestDiffGameliftPortSettings(t *testing.T) {
 		{ // Removal + Addition
 			Old: []interface{}{
 				map[string]interface{}{
-					"from_port": 8443,
-					"ip_range":  "192.168.0.0/24",
-					"protocol":  "TCP",
-					"to_port":   8443,
+					"from_port":  8443,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8443,
 				},
 			},
 			New: []interface{}{
 				map[string]interface{}{
-					"from_port": 8443,
-					"ip_range":  "192.168.0.0/24",
-					"protocol":  "UDP",
-					"to_port":   8443,
+					"from_port":  8443,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "UDP",
+					"to_port":    8443,
 				},
 			},
 			ExpectedAuths: []*gamelift.IpPermission{
@@ -130,6 +130,88 @@ func TestDiffGameliftPortSettings(t *testing.T) {
 				},
 			},
 		},
+		{ // Multiple simultaneous changes
+			Old: []interface{}{
+				map[string]interface{}{
+					"from_port":  8001,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8001,
+				},
+				map[string]interface{}{
+					"from_port":  8002,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8002,
+				},
+				map[string]interface{}{
+					"from_port":  8003,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8003,
+				},
+				map[string]interface{}{
+					"from_port":  8004,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8004,
+				},
+			},
+			New: []interface{}{
+				map[string]interface{}{
+					"from_port":  8002,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8002,
+				},
+				map[string]interface{}{
+					"from_port":  8005,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8005,
+				},
+				map[string]interface{}{
+					"from_port":  8004,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8004,
+				},
+				map[string]interface{}{
+					"from_port":  8006,
+					"cidr_block": "192.168.0.0/24",
+					"protocol":   "TCP",
+					"to_port":    8006,
+				},
+			},
+			ExpectedAuths: []*gamelift.IpPermission{
+				{
+					FromPort: aws.Int64(8005),
+					IpRange:  aws.String("192.168.0.0/24"),
+					Protocol: aws.String("TCP"),
+					ToPort:   aws.Int64(8005),
+				},
+				{
+					FromPort: aws.Int64(8006),
+					IpRange:  aws.String("192.168.0.0/24"),
+					Protocol: aws.String("TCP"),
+					ToPort:   aws.Int64(8006),
+				},
+			},
+			ExpectedRevs: []*gamelift.IpPermission{
+				{
+					FromPort: aws.Int64(8001),
+					IpRange:  aws.String("192.168.0.0/24"),
+					Protocol: aws.String("TCP"),
+					ToPort:   aws.Int64(8001),
+				},
+				{
+					FromPort: aws.Int64(8003),
+					IpRange:  aws.String("192.168.0.0/24"),
+					Protocol: aws.String("TCP"),
+					ToPort:   aws.Int64(8003),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -149,6 +231,66 @@ func TestDiffGameliftPortSettings(t *testing.T) {
 	}
 }
 
+func TestBatchGameliftPortSettings(t *testing.T) {
+	permissions := func(n int) []*gamelift.IpPermission {
+		var l []*gamelift.IpPermission
+		for i := 0; i < n; i++ {
+			l = append(l, &gamelift.IpPermission{
+				FromPort: aws.Int64(int64(8000 + i)),
+				IpRange:  aws.String("192.168.0.0/24"),
+				Protocol: aws.String("TCP"),
+				ToPort:   aws.Int64(int64(8000 + i)),
+			})
+		}
+		return l
+	}
+
+	testCases := []struct {
+		Name           string
+		Authorizations int
+		Revocations    int
+		Expected       [][2]int
+	}{
+		{
+			Name: "none",
+		},
+		{
+			Name:           "single batch",
+			Authorizations: 20,
+			Revocations:    30,
+			Expected:       [][2]int{{20, 30}},
+		},
+		{
+			Name:           "revocations first",
+			Authorizations: 50,
+			Revocations:    50,
+			Expected:       [][2]int{{0, 50}, {50, 0}},
+		},
+		{
+			Name:           "mixed batch",
+			Authorizations: 40,
+			Revocations:    30,
+			Expected:       [][2]int{{20, 30}, {20, 0}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			batches := tfgamelift.BatchPortSettings(permissions(tc.Authorizations), permissions(tc.Revocations), 50)
+
+			if len(batches) != len(tc.Expected) {
+				t.Fatalf("expected %d batches, got %d", len(tc.Expected), len(batches))
+			}
+
+			for i, batch := range batches {
+				if got := [2]int{len(batch.Authorizations), len(batch.Revocations)}; got != tc.Expected[i] {
+					t.Errorf("batch %d: expected (authorizations, revocations) %v, got %v", i, tc.Expected[i], got)
+				}
+			}
+		})
+	}
+}
+
 func TestAccGameLiftFleet_basic(t *testing.T) {
 	var conf gamelift.FleetAttributes
 
@@ -362,22 +504,22 @@ func TestAccGameLiftFleet_allFields(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", desc),
 					resource.TestCheckResourceAttr(resourceName, "ec2_inbound_permission.#", "3"),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ec2_inbound_permission.*", map[string]string{
-						"from_port": "8080",
-						"ip_range":  "8.8.8.8/32",
-						"protocol":  "TCP",
-						"to_port":   "8080",
+						"from_port":  "8080",
+						"cidr_block": "8.8.8.8/32",
+						"protocol":   "TCP",
+						"to_port":    "8080",
 					}),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ec2_inbound_permission.*", map[string]string{
-						"from_port": "8443",
-						"ip_range":  "8.8.0.0/16",
-						"protocol":  "TCP",
-						"to_port":   "8443",
+						"from_port":  "8443",
+						"cidr_block": "8.8.0.0/16",
+						"protocol":   "TCP",
+						"to_port":    "8443",
 					}),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ec2_inbound_permission.*", map[string]string{
-						"from_port": "60000",
-						"ip_range":  "8.8.8.8/32",
-						"protocol":  "UDP",
-						"to_port":   "60000",
+						"from_port":  "60000",
+						"cidr_block": "8.8.8.8/32",
+						"protocol":   "UDP",
+						"to_port":    "60000",
 					}),
 					resource.TestCheckResourceAttr(resourceName, "log_paths.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "metric_groups.#", "1"),
@@ -413,22 +555,22 @@ func TestAccGameLiftFleet_allFields(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", desc),
 					resource.TestCheckResourceAttr(resourceName, "ec2_inbound_permission.#", "3"),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ec2_inbound_permission.*", map[string]string{
-						"from_port": "8888",
-						"ip_range":  "8.8.8.8/32",
-						"protocol":  "TCP",
-						"to_port":   "8888",
+						"from_port":  "8888",
+						"cidr_block": "8.8.8.8/32",
+						"protocol":   "TCP",
+						"to_port":    "8888",
 					}),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ec2_inbound_permission.*", map[string]string{
-						"from_port": "8443",
-						"ip_range":  "8.4.0.0/16",
-						"protocol":  "TCP",
-						"to_port":   "8443",
+						"from_port":  "8443",
+						"cidr_block": "8.4.0.0/16",
+						"protocol":   "TCP",
+						"to_port":    "8443",
 					}),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ec2_inbound_permission.*", map[string]string{
-						"from_port": "60000",
-						"ip_range":  "8.8.8.8/32",
-						"protocol":  "UDP",
-						"to_port":   "60000",
+						"from_port":  "60000",
+						"cidr_block": "8.8.8.8/32",
+						"protocol":   "UDP",
+						"to_port":    "60000",
 					}),
 					resource.TestCheckResourceAttr(resourceName, "log_paths.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "metric_groups.#", "1"),
@@ -701,24 +843,24 @@ resource "aws_gamelift_fleet" "test" {
   fleet_type        = "ON_DEMAND"
 
   ec2_inbound_permission {
-    from_port = 8080
-    ip_range  = "8.8.8.8/32"
-    protocol  = "TCP"
-    to_port   = 8080
+    cidr_block = "8.8.8.8/32"
+    from_port  = 8080
+    protocol   = "TCP"
+    to_port    = 8080
   }
 
   ec2_inbound_permission {
-    from_port = 8443
-    ip_range  = "8.8.0.0/16"
-    protocol  = "TCP"
-    to_port   = 8443
+    cidr_block = "8.8.0.0/16"
+    from_port  = 8443
+    protocol   = "TCP"
+    to_port    = 8443
   }
 
   ec2_inbound_permission {
-    from_port = 60000
-    ip_range  = "8.8.8.8/32"
-    protocol  = "UDP"
-    to_port   = 60000
+    cidr_block = "8.8.8.8/32"
+    from_port  = 60000
+    protocol   = "UDP"
+    to_port    = 60000
   }
 
   metric_groups                      = ["TerraformAccTest"]
@@ -756,24 +898,24 @@ resource "aws_gamelift_fleet" "test" {
   fleet_type        = "ON_DEMAND"
 
   ec2_inbound_permission {
-    from_port = 8888
-    ip_range  = "8.8.8.8/32"
-    protocol  = "TCP"
-    to_port   = 8888
+    cidr_block = "8.8.8.8/32"
+    from_port  = 8888
+    protocol   = "TCP"
+    to_port    = 8888
   }
 
   ec2_inbound_permission {
-    from_port = 8443
-    ip_range  = "8.4.0.0/16"
-    protocol  = "TCP"
-    to_port   = 8443
+    cidr_block = "8.4.0.0/16"
+    from_port  = 8443
+    protocol   = "TCP"
+    to_port    = 8443
   }
 
   ec2_inbound_permission {
-    from_port = 60000
-    ip_range  = "8.8.8.8/32"
-    protocol  = "UDP"
-    to_port   = 60000
+    cidr_block = "8.8.8.8/32"
+    from_port  = 60000
+    protocol   = "UDP"
+    to_port    = 60000
   }
 
   metric_groups                      = ["TerraformAccTest"]
@@ -1,16 +1,20 @@
 package gamelift
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -28,7 +32,10 @@ func ResourceGameSessionQueue() *schema.Resource {
 			"destinations": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validGameSessionQueueDestinationARN,
+				},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -68,10 +75,38 @@ func ResourceGameSessionQueue() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceGameSessionQueueCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceGameSessionQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	partition := meta.(*conns.AWSClient).Partition
+
+	// Destinations may be in any region but must be in the provider's partition.
+	for i, v := range diff.Get("destinations").([]interface{}) {
+		// Unknown values (e.g. a fleet being created in the same plan) are returned as "".
+		destination, ok := v.(string)
+		if !ok || destination == "" {
+			continue
+		}
+
+		parsedARN, err := arn.Parse(destination)
+
+		if err != nil {
+			continue
+		}
+
+		if parsedARN.Partition != partition {
+			return fmt.Errorf("destinations.%d (%s) must be in the %q partition", i, destination, partition)
+		}
+	}
+
+	return nil
+}
+
 func resourceGameSessionQueueCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).GameLiftConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -100,32 +135,17 @@ func resourceGameSessionQueueRead(d *schema.ResourceData, meta interface{}) erro
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	log.Printf("[INFO] Describing Gamelift Session Queues: %s", d.Id())
-	limit := int64(1)
-	out, err := conn.DescribeGameSessionQueues(&gamelift.DescribeGameSessionQueuesInput{
-		Names: aws.StringSlice([]string{d.Id()}),
-		Limit: &limit,
-	})
-	if err != nil {
-		if tfawserr.ErrMessageContains(err, gamelift.ErrCodeNotFoundException, "") {
-			log.Printf("[WARN] Gamelift Session Queues (%s) not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-		return fmt.Errorf("error reading Gamelift Game Session Queue (%s): %s", d.Id(), err)
-	}
-	sessionQueues := out.GameSessionQueues
+	sessionQueue, err := FindGameSessionQueueByName(conn, d.Id())
 
-	if len(sessionQueues) < 1 {
-		log.Printf("[WARN] Gamelift Session Queue (%s) not found, removing from state", d.Id())
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Gamelift Game Session Queue (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
-	if len(sessionQueues) != 1 {
-		return fmt.Errorf("expected exactly 1 Gamelift Session Queues, found %d under %q",
-			len(sessionQueues), d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Gamelift Game Session Queue (%s): %w", d.Id(), err)
 	}
-	sessionQueue := sessionQueues[0]
 
 	arn := aws.StringValue(sessionQueue.GameSessionQueueArn)
 	d.Set("arn", arn)
@@ -8,12 +8,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const testAccGameliftGameSessionQueuePrefix = "tfAccQueue-"
@@ -196,6 +197,35 @@ func TestAccGameLiftGameSessionQueue_disappears(t *testing.T) {
 	})
 }
 
+func TestAccGameLiftGameSessionQueue_invalidDestination(t *testing.T) {
+	rName := testAccGameliftGameSessionQueuePrefix + sdkacctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, gamelift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGameSessionQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGameSessionQueueDestinationConfig(rName, fmt.Sprintf("arn:%s:ec2:%s:123456789012:fleet/fleet-a1234567-b8c9-0d1e-2fa3-b45c6d7e8912", acctest.Partition(), acctest.Region())),
+				ExpectError: regexp.MustCompile(`must be a GameLift ARN`),
+			},
+			{
+				Config:      testAccGameSessionQueueDestinationConfig(rName, fmt.Sprintf("arn:%s:gamelift:%s:123456789012:build/build-a1234567-b8c9-0d1e-2fa3-b45c6d7e8912", acctest.Partition(), acctest.Region())),
+				ExpectError: regexp.MustCompile(`must be a GameLift fleet or alias ARN`),
+			},
+			{
+				Config:      testAccGameSessionQueueDestinationConfig(rName, fmt.Sprintf("arn:%s-invalid:gamelift:%s:123456789012:fleet/fleet-a1234567-b8c9-0d1e-2fa3-b45c6d7e8912", acctest.Partition(), acctest.Region())),
+				ExpectError: regexp.MustCompile(`must be in the .* partition`),
+			},
+		},
+	})
+}
+
 func testAccCheckGameSessionQueueExists(n string, res *gamelift.GameSessionQueue) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -209,28 +239,11 @@ func testAccCheckGameSessionQueueExists(n string, res *gamelift.GameSessionQueue
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
 
-		name := rs.Primary.Attributes["name"]
-		limit := int64(1)
-		out, err := conn.DescribeGameSessionQueues(&gamelift.DescribeGameSessionQueuesInput{
-			Names: aws.StringSlice([]string{name}),
-			Limit: &limit,
-		})
+		queue, err := tfgamelift.FindGameSessionQueueByName(conn, rs.Primary.Attributes["name"])
+
 		if err != nil {
 			return err
 		}
-		attributes := out.GameSessionQueues
-		if len(attributes) < 1 {
-			return fmt.Errorf("gmelift Session Queue %q not found", name)
-		}
-		if len(attributes) != 1 {
-			return fmt.Errorf("expected exactly 1 Gamelift Session Queue, found %d under %q",
-				len(attributes), name)
-		}
-		queue := attributes[0]
-
-		if *queue.Name != name {
-			return fmt.Errorf("gamelift Session Queue not found")
-		}
 
 		*res = *queue
 
@@ -258,16 +271,11 @@ func testAccCheckGameSessionQueueDestroy(s *terraform.State) error {
 			continue
 		}
 
-		input := &gamelift.DescribeGameSessionQueuesInput{
-			Names: aws.StringSlice([]string{rs.Primary.ID}),
-			Limit: aws.Int64(1),
-		}
-
 		// Deletions can take a few seconds
 		err := resource.Retry(30*time.Second, func() *resource.RetryError {
-			out, err := conn.DescribeGameSessionQueues(input)
+			_, err := tfgamelift.FindGameSessionQueueByName(conn, rs.Primary.ID)
 
-			if tfawserr.ErrMessageContains(err, gamelift.ErrCodeNotFoundException, "") {
+			if tfresource.NotFound(err) {
 				return nil
 			}
 
@@ -275,13 +283,7 @@ func testAccCheckGameSessionQueueDestroy(s *terraform.State) error {
 				return resource.NonRetryableError(err)
 			}
 
-			attributes := out.GameSessionQueues
-
-			if len(attributes) > 0 {
-				return resource.RetryableError(fmt.Errorf("gamelift Session Queue still exists"))
-			}
-
-			return nil
+			return resource.RetryableError(fmt.Errorf("Gamelift Game Session Queue %s still exists", rs.Primary.ID))
 		})
 
 		if err != nil {
@@ -366,3 +368,12 @@ resource "aws_gamelift_game_session_queue" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccGameSessionQueueDestinationConfig(rName, destination string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_game_session_queue" "test" {
+  name         = %[1]q
+  destinations = [%[2]q]
+}
+`, rName, destination)
+}
@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=ResourceARN -ServiceTagsSlice -TagInIDElem=ResourceARN -UpdateTags
+//go:generate go run ../../generate/finders/main.go -NotFoundErrCode=ErrCodeNotFoundException -Finder=Name=Alias,By=ID,Op=DescribeAlias,InputField=AliasId,OutputField=Alias -Finder=Name=Build,By=ID,Op=DescribeBuild,InputField=BuildId,OutputField=Build -Finder=Name=Fleet,By=ID,Op=DescribeFleetAttributes,InputField=FleetIds,OutputField=FleetAttributes,MatchField=FleetId -Finder=Name=GameSessionQueue,By=Name,Op=DescribeGameSessionQueues,InputField=Names,OutputField=GameSessionQueues,MatchField=Name
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package gamelift
@@ -0,0 +1,40 @@
+package gamelift
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+)
+
+// validGameSessionQueueDestinationARN validates that a game session queue
+// destination is a GameLift fleet or alias ARN.
+func validGameSessionQueueDestinationARN(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	parsedARN, err := arn.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %w", k, value, err))
+		return
+	}
+
+	if parsedARN.Service != gamelift.EndpointsID {
+		errors = append(errors, fmt.Errorf("%q (%s) must be a GameLift ARN, got service %q", k, value, parsedARN.Service))
+		return
+	}
+
+	if parsedARN.Region == "" {
+		errors = append(errors, fmt.Errorf("%q (%s) must include a region", k, value))
+	}
+
+	if !strings.HasPrefix(parsedARN.Resource, "fleet/fleet-") && !strings.HasPrefix(parsedARN.Resource, "alias/alias-") {
+		errors = append(errors, fmt.Errorf("%q (%s) must be a GameLift fleet or alias ARN", k, value))
+	}
+
+	return
+}
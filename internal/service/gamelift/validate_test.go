@@ -0,0 +1,32 @@
+package gamelift
+
+import (
+	"testing"
+)
+
+func TestValidGameSessionQueueDestinationARN(t *testing.T) {
+	validARNs := []string{
+		"arn:aws:gamelift:us-west-2:123456789012:fleet/fleet-a1234567-b8c9-0d1e-2fa3-b45c6d7e8912",     //lintignore:AWSAT003,AWSAT005
+		"arn:aws:gamelift:eu-west-1:123456789012:alias/alias-a1234567-b8c9-0d1e-2fa3-b45c6d7e8912",     //lintignore:AWSAT003,AWSAT005
+		"arn:aws-cn:gamelift:cn-north-1:123456789012:fleet/fleet-a1234567-b8c9-0d1e-2fa3-b45c6d7e8912", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validGameSessionQueueDestinationARN(v, "destinations")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid game session queue destination ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"arn:aws:gamelift:us-west-2:123456789012:build/build-a1234567-b8c9-0d1e-2fa3-b45c6d7e8912", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:gamelift::123456789012:fleet/fleet-a1234567-b8c9-0d1e-2fa3-b45c6d7e8912",          //lintignore:AWSAT005
+		"arn:aws:ec2:us-west-2:123456789012:fleet/fleet-a1234567-b8c9-0d1e-2fa3-b45c6d7e8912",      //lintignore:AWSAT003,AWSAT005
+		"fleet-a1234567-b8c9-0d1e-2fa3-b45c6d7e8912",
+	}
+	for _, v := range invalidARNs {
+		_, errors := validGameSessionQueueDestinationARN(v, "destinations")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid game session queue destination ARN", v)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+package glacier
+
+const (
+	vaultLockStateInProgress = "InProgress"
+	vaultLockStateLocked     = "Locked"
+)
@@ -1,18 +1,19 @@
 package glacier
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glacier"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -20,24 +21,39 @@ func ResourceVaultLock() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVaultLockCreate,
 		Read:   resourceVaultLockRead,
-		// Allow ignore_deletion_error update
-		Update: schema.Noop,
+		Update: resourceVaultLockUpdate,
 		Delete: resourceVaultLockDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customdiff.ForceNewIfChange("complete_lock", func(_ context.Context, old, new, meta interface{}) bool {
+			// A completed lock cannot be reverted to testing mode.
+			return old.(bool) && !new.(bool)
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"complete_lock": {
 				Type:     schema.TypeBool,
 				Required: true,
-				ForceNew: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"ignore_deletion_error": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"lock_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -49,6 +65,10 @@ func ResourceVaultLock() *schema.Resource {
 					return json
 				},
 			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"vault_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -63,44 +83,19 @@ func resourceVaultLockCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).GlacierConn
 	vaultName := d.Get("vault_name").(string)
 
-	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
-
-	if err != nil {
-		return fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
-	}
-
-	input := &glacier.InitiateVaultLockInput{
-		AccountId: aws.String("-"),
-		Policy: &glacier.VaultLockPolicy{
-			Policy: aws.String(policy),
-		},
-		VaultName: aws.String(vaultName),
-	}
+	lockID, err := initiateVaultLock(conn, vaultName, d.Get("policy").(string))
 
-	log.Printf("[DEBUG] Initiating Glacier Vault Lock: %s", input)
-	output, err := conn.InitiateVaultLock(input)
 	if err != nil {
-		return fmt.Errorf("error initiating Glacier Vault Lock: %s", err)
+		return err
 	}
 
 	d.SetId(vaultName)
+	d.Set("lock_id", lockID)
 
-	if !d.Get("complete_lock").(bool) {
-		return resourceVaultLockRead(d, meta)
-	}
-
-	completeLockInput := &glacier.CompleteVaultLockInput{
-		LockId:    output.LockId,
-		VaultName: aws.String(vaultName),
-	}
-
-	log.Printf("[DEBUG] Completing Glacier Vault (%s) Lock: %s", vaultName, completeLockInput)
-	if _, err := conn.CompleteVaultLock(completeLockInput); err != nil {
-		return fmt.Errorf("error completing Glacier Vault (%s) Lock: %s", vaultName, err)
-	}
-
-	if err := waitVaultLockCompletion(conn, vaultName); err != nil {
-		return fmt.Errorf("error waiting for Glacier Vault Lock (%s) completion: %s", d.Id(), err)
+	if d.Get("complete_lock").(bool) {
+		if err := completeVaultLock(conn, vaultName, lockID); err != nil {
+			return err
+		}
 	}
 
 	return resourceVaultLockRead(d, meta)
@@ -109,31 +104,24 @@ func resourceVaultLockCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceVaultLockRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).GlacierConn
 
-	input := &glacier.GetVaultLockInput{
-		AccountId: aws.String("-"),
-		VaultName: aws.String(d.Id()),
-	}
-
-	log.Printf("[DEBUG] Reading Glacier Vault Lock (%s): %s", d.Id(), input)
-	output, err := conn.GetVaultLock(input)
+	output, err := FindVaultLockByName(conn, d.Id())
 
-	if tfawserr.ErrMessageContains(err, glacier.ErrCodeResourceNotFoundException, "") {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Glacier Vault Lock (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading Glacier Vault Lock (%s): %s", d.Id(), err)
+		return fmt.Errorf("error reading Glacier Vault Lock (%s): %w", d.Id(), err)
 	}
 
-	if output == nil {
-		log.Printf("[WARN] Glacier Vault Lock (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
+	state := aws.StringValue(output.State)
 
-	d.Set("complete_lock", aws.StringValue(output.State) == "Locked")
+	d.Set("complete_lock", state == vaultLockStateLocked)
+	d.Set("creation_date", output.CreationDate)
+	d.Set("expiration_date", output.ExpirationDate)
+	d.Set("state", state)
 	d.Set("vault_name", d.Id())
 
 	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
@@ -147,63 +135,107 @@ func resourceVaultLockRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceVaultLockDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceVaultLockUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).GlacierConn
 
-	input := &glacier.AbortVaultLockInput{
-		VaultName: aws.String(d.Id()),
-	}
+	if d.HasChange("complete_lock") && d.Get("complete_lock").(bool) {
+		lockID := d.Get("lock_id").(string)
 
-	log.Printf("[DEBUG] Aborting Glacier Vault Lock (%s): %s", d.Id(), input)
-	_, err := conn.AbortVaultLock(input)
+		// The lock ID is only returned when the lock is initiated, e.g. it is unknown after import.
+		// Restart the test period to obtain a new one.
+		if lockID == "" {
+			if err := abortVaultLock(conn, d.Id()); err != nil {
+				return err
+			}
 
-	if tfawserr.ErrMessageContains(err, glacier.ErrCodeResourceNotFoundException, "") {
-		return nil
+			var err error
+			lockID, err = initiateVaultLock(conn, d.Id(), d.Get("policy").(string))
+
+			if err != nil {
+				return err
+			}
+
+			d.Set("lock_id", lockID)
+		}
+
+		if err := completeVaultLock(conn, d.Id(), lockID); err != nil {
+			return err
+		}
 	}
 
+	return resourceVaultLockRead(d, meta)
+}
+
+func resourceVaultLockDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlacierConn
+
+	err := abortVaultLock(conn, d.Id())
+
 	if err != nil && !d.Get("ignore_deletion_error").(bool) {
-		return fmt.Errorf("error aborting Glacier Vault Lock (%s): %s", d.Id(), err)
+		return err
 	}
 
 	return nil
 }
 
-func vaultLockRefreshFunc(conn *glacier.Glacier, vaultName string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		input := &glacier.GetVaultLockInput{
-			AccountId: aws.String("-"),
-			VaultName: aws.String(vaultName),
-		}
+func initiateVaultLock(conn *glacier.Glacier, vaultName, rawPolicy string) (string, error) {
+	policy, err := structure.NormalizeJsonString(rawPolicy)
 
-		log.Printf("[DEBUG] Reading Glacier Vault Lock (%s): %s", vaultName, input)
-		output, err := conn.GetVaultLock(input)
+	if err != nil {
+		return "", fmt.Errorf("policy (%s) is invalid JSON: %w", rawPolicy, err)
+	}
 
-		if tfawserr.ErrMessageContains(err, glacier.ErrCodeResourceNotFoundException, "") {
-			return nil, "", nil
-		}
+	input := &glacier.InitiateVaultLockInput{
+		AccountId: aws.String("-"),
+		Policy: &glacier.VaultLockPolicy{
+			Policy: aws.String(policy),
+		},
+		VaultName: aws.String(vaultName),
+	}
 
-		if err != nil {
-			return nil, "", fmt.Errorf("error reading Glacier Vault Lock (%s): %s", vaultName, err)
-		}
+	log.Printf("[DEBUG] Initiating Glacier Vault Lock: %s", input)
+	output, err := conn.InitiateVaultLock(input)
 
-		if output == nil {
-			return nil, "", nil
-		}
+	if err != nil {
+		return "", fmt.Errorf("error initiating Glacier Vault (%s) Lock: %w", vaultName, err)
+	}
 
-		return output, aws.StringValue(output.State), nil
+	return aws.StringValue(output.LockId), nil
+}
+
+func completeVaultLock(conn *glacier.Glacier, vaultName, lockID string) error {
+	input := &glacier.CompleteVaultLockInput{
+		LockId:    aws.String(lockID),
+		VaultName: aws.String(vaultName),
 	}
+
+	log.Printf("[DEBUG] Completing Glacier Vault (%s) Lock: %s", vaultName, input)
+	if _, err := conn.CompleteVaultLock(input); err != nil {
+		return fmt.Errorf("error completing Glacier Vault (%s) Lock: %w", vaultName, err)
+	}
+
+	if _, err := waitVaultLockCompleted(conn, vaultName); err != nil {
+		return fmt.Errorf("error waiting for Glacier Vault Lock (%s) completion: %w", vaultName, err)
+	}
+
+	return nil
 }
 
-func waitVaultLockCompletion(conn *glacier.Glacier, vaultName string) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"InProgress"},
-		Target:  []string{"Locked"},
-		Refresh: vaultLockRefreshFunc(conn, vaultName),
-		Timeout: 5 * time.Minute,
+func abortVaultLock(conn *glacier.Glacier, vaultName string) error {
+	input := &glacier.AbortVaultLockInput{
+		VaultName: aws.String(vaultName),
 	}
 
-	log.Printf("[DEBUG] Waiting for Glacier Vault Lock (%s) completion", vaultName)
-	_, err := stateConf.WaitForState()
+	log.Printf("[DEBUG] Aborting Glacier Vault Lock (%s): %s", vaultName, input)
+	_, err := conn.AbortVaultLock(input)
 
-	return err
+	if tfawserr.ErrCodeEquals(err, glacier.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error aborting Glacier Vault Lock (%s): %w", vaultName, err)
+	}
+
+	return nil
 }
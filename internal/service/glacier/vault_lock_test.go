@@ -6,12 +6,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glacier"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglacier "github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func TestAccGlacierVaultLock_basic(t *testing.T) {
@@ -31,8 +32,12 @@ func TestAccGlacierVaultLock_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVaultLockExists(resourceName, &vaultLock1),
 					resource.TestCheckResourceAttr(resourceName, "complete_lock", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
 					resource.TestCheckResourceAttr(resourceName, "ignore_deletion_error", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "lock_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttr(resourceName, "state", "InProgress"),
 					resource.TestCheckResourceAttrPair(resourceName, "vault_name", vaultResourceName, "name"),
 				),
 			},
@@ -40,7 +45,7 @@ func TestAccGlacierVaultLock_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ignore_deletion_error"},
+				ImportStateVerifyIgnore: []string{"ignore_deletion_error", "lock_id"},
 			},
 		},
 	})
@@ -65,6 +70,7 @@ func TestAccGlacierVaultLock_completeLock(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "complete_lock", "true"),
 					resource.TestCheckResourceAttr(resourceName, "ignore_deletion_error", "true"),
 					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Locked"),
 					resource.TestCheckResourceAttrPair(resourceName, "vault_name", vaultResourceName, "name"),
 				),
 			},
@@ -72,7 +78,39 @@ func TestAccGlacierVaultLock_completeLock(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ignore_deletion_error"},
+				ImportStateVerifyIgnore: []string{"ignore_deletion_error", "lock_id"},
+			},
+		},
+	})
+}
+
+func TestAccGlacierVaultLock_completeLockUpdate(t *testing.T) {
+	var vaultLock1, vaultLock2 glacier.GetVaultLockOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glacier_vault_lock.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glacier.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVaultLockDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultLockConfigCompleteLock(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockExists(resourceName, &vaultLock1),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "InProgress"),
+				),
+			},
+			{
+				Config: testAccVaultLockConfigCompleteLock(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockExists(resourceName, &vaultLock2),
+					testAccCheckVaultLockNotRecreated(&vaultLock1, &vaultLock2),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Locked"),
+				),
 			},
 		},
 	})
@@ -116,22 +154,15 @@ func testAccCheckVaultLockExists(resourceName string, getVaultLockOutput *glacie
 		}
 
 		if rs.Primary.ID == "" {
-			return fmt.Errorf("No ID is set")
+			return fmt.Errorf("No Glacier Vault Lock ID is set")
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierConn
 
-		input := &glacier.GetVaultLockInput{
-			VaultName: aws.String(rs.Primary.ID),
-		}
-		output, err := conn.GetVaultLock(input)
+		output, err := tfglacier.FindVaultLockByName(conn, rs.Primary.ID)
 
 		if err != nil {
-			return fmt.Errorf("error reading Glacier Vault Lock (%s): %s", rs.Primary.ID, err)
-		}
-
-		if output == nil {
-			return fmt.Errorf("error reading Glacier Vault Lock (%s): empty response", rs.Primary.ID)
+			return err
 		}
 
 		*getVaultLockOutput = *output
@@ -140,6 +171,16 @@ func testAccCheckVaultLockExists(resourceName string, getVaultLockOutput *glacie
 	}
 }
 
+func testAccCheckVaultLockNotRecreated(i, j *glacier.GetVaultLockOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.CreationDate) != aws.StringValue(j.CreationDate) {
+			return fmt.Errorf("Glacier Vault Lock was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckVaultLockDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierConn
 
@@ -148,22 +189,17 @@ func testAccCheckVaultLockDestroy(s *terraform.State) error {
 			continue
 		}
 
-		input := &glacier.GetVaultLockInput{
-			VaultName: aws.String(rs.Primary.ID),
-		}
-		output, err := conn.GetVaultLock(input)
+		_, err := tfglacier.FindVaultLockByName(conn, rs.Primary.ID)
 
-		if tfawserr.ErrMessageContains(err, glacier.ErrCodeResourceNotFoundException, "") {
+		if tfresource.NotFound(err) {
 			continue
 		}
 
 		if err != nil {
-			return fmt.Errorf("error reading Glacier Vault Lock (%s): %s", rs.Primary.ID, err)
+			return err
 		}
 
-		if output != nil {
-			return fmt.Errorf("Glacier Vault Lock (%s) still exists", rs.Primary.ID)
-		}
+		return fmt.Errorf("Glacier Vault Lock %s still exists", rs.Primary.ID)
 	}
 
 	return nil
@@ -0,0 +1,29 @@
+package glacier
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	vaultLockCompletedTimeout = 5 * time.Minute
+)
+
+func waitVaultLockCompleted(conn *glacier.Glacier, name string) (*glacier.GetVaultLockOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vaultLockStateInProgress},
+		Target:  []string{vaultLockStateLocked},
+		Refresh: statusVaultLock(conn, name),
+		Timeout: vaultLockCompletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*glacier.GetVaultLockOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
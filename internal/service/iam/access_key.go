@@ -1,15 +1,19 @@
 package iam
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -22,6 +26,8 @@ func ResourceAccessKey() *schema.Resource {
 		Update: resourceAccessKeyUpdate,
 		Delete: resourceAccessKeyDelete,
 
+		CustomizeDiff: resourceAccessKeyCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			// ListAccessKeys requires UserName field in certain scenarios:
 			//   ValidationError: Must specify userName when calling with non-User credentials
@@ -50,6 +56,10 @@ func ResourceAccessKey() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"access_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"create_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -71,6 +81,34 @@ func ResourceAccessKey() *schema.Resource {
 				ForceNew: true,
 				Optional: true,
 			},
+			"previous_access_key_deactivation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"previous_access_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"deactivation_grace_period": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "168h",
+							ValidateFunc: validAccessKeyRotationDuration,
+						},
+						"rotate_after": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validAccessKeyRotationDuration,
+						},
+					},
+				},
+			},
 			"secret": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -99,39 +137,74 @@ func ResourceAccessKey() *schema.Resource {
 func resourceAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).IAMConn
 
+	accessKey, err := createAccessKey(d, meta)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(aws.StringValue(accessKey.AccessKeyId))
+
+	if v, ok := d.GetOk("status"); ok && v.(string) == iam.StatusTypeInactive {
+		input := &iam.UpdateAccessKeyInput{
+			AccessKeyId: aws.String(d.Id()),
+			Status:      aws.String(iam.StatusTypeInactive),
+			UserName:    aws.String(d.Get("user").(string)),
+		}
+
+		_, err := conn.UpdateAccessKey(input)
+
+		if err != nil {
+			return fmt.Errorf("error deactivating IAM Access Key (%s): %w", d.Id(), err)
+		}
+
+		accessKey.Status = aws.String(iam.StatusTypeInactive)
+	}
+
+	return resourceAccessKeyReadResult(d, &iam.AccessKeyMetadata{
+		AccessKeyId: accessKey.AccessKeyId,
+		CreateDate:  accessKey.CreateDate,
+		Status:      accessKey.Status,
+		UserName:    accessKey.UserName,
+	})
+}
+
+// createAccessKey creates a new access key for the configured user and stores its secret,
+// encrypted with the configured PGP key if any.
+func createAccessKey(d *schema.ResourceData, meta interface{}) (*iam.AccessKey, error) {
+	conn := meta.(*conns.AWSClient).IAMConn
+
 	request := &iam.CreateAccessKeyInput{
 		UserName: aws.String(d.Get("user").(string)),
 	}
 
 	createResp, err := conn.CreateAccessKey(request)
 	if err != nil {
-		return fmt.Errorf(
+		return nil, fmt.Errorf(
 			"Error creating access key for user %s: %s",
 			*request.UserName,
 			err,
 		)
 	}
 
-	d.SetId(aws.StringValue(createResp.AccessKey.AccessKeyId))
-
 	if createResp.AccessKey == nil || createResp.AccessKey.SecretAccessKey == nil {
-		return fmt.Errorf("CreateAccessKey response did not contain a Secret Access Key as expected")
+		return nil, fmt.Errorf("CreateAccessKey response did not contain a Secret Access Key as expected")
 	}
 
 	sesSMTPPasswordV4, err := SessmTPPasswordFromSecretKeySigV4(createResp.AccessKey.SecretAccessKey, meta.(*conns.AWSClient).Region)
 	if err != nil {
-		return fmt.Errorf("error getting SES SigV4 SMTP Password from Secret Access Key: %s", err)
+		return nil, fmt.Errorf("error getting SES SigV4 SMTP Password from Secret Access Key: %s", err)
 	}
 
 	if v, ok := d.GetOk("pgp_key"); ok {
 		pgpKey := v.(string)
 		encryptionKey, err := RetrieveGPGKey(pgpKey)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		fingerprint, encrypted, err := EncryptValue(encryptionKey, *createResp.AccessKey.SecretAccessKey, "IAM Access Key Secret")
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		d.Set("key_fingerprint", fingerprint)
@@ -139,42 +212,21 @@ func resourceAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 		_, encrypted, err = EncryptValue(encryptionKey, sesSMTPPasswordV4, "SES SMTP password")
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		d.Set("encrypted_ses_smtp_password_v4", encrypted)
 	} else {
 		if err := d.Set("secret", createResp.AccessKey.SecretAccessKey); err != nil {
-			return err
+			return nil, err
 		}
 
 		if err := d.Set("ses_smtp_password_v4", sesSMTPPasswordV4); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	if v, ok := d.GetOk("status"); ok && v.(string) == iam.StatusTypeInactive {
-		input := &iam.UpdateAccessKeyInput{
-			AccessKeyId: aws.String(d.Id()),
-			Status:      aws.String(iam.StatusTypeInactive),
-			UserName:    aws.String(d.Get("user").(string)),
-		}
-
-		_, err := conn.UpdateAccessKey(input)
-
-		if err != nil {
-			return fmt.Errorf("error deactivating IAM Access Key (%s): %w", d.Id(), err)
-		}
-
-		createResp.AccessKey.Status = aws.String(iam.StatusTypeInactive)
-	}
-
-	return resourceAccessKeyReadResult(d, &iam.AccessKeyMetadata{
-		AccessKeyId: createResp.AccessKey.AccessKeyId,
-		CreateDate:  createResp.AccessKey.CreateDate,
-		Status:      createResp.AccessKey.Status,
-		UserName:    createResp.AccessKey.UserName,
-	})
+	return createResp.AccessKey, nil
 }
 
 func resourceAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
@@ -194,8 +246,29 @@ func resourceAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error reading IAM access key: %s", err)
 	}
 
+	// A previous key removed outside of Terraform no longer needs to be cleaned up.
+	if v := d.Get("previous_access_key_id").(string); v != "" {
+		found := false
+
+		for _, key := range getResp.AccessKeyMetadata {
+			if aws.StringValue(key.AccessKeyId) == v {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			d.Set("previous_access_key_deactivation_date", "")
+			d.Set("previous_access_key_id", "")
+		}
+	}
+
+	id := accessKeyID(d)
+
 	for _, key := range getResp.AccessKeyMetadata {
-		if aws.StringValue(key.AccessKeyId) == d.Id() {
+		if aws.StringValue(key.AccessKeyId) == id {
+			d.SetId(id)
+
 			return resourceAccessKeyReadResult(d, key)
 		}
 	}
@@ -206,7 +279,7 @@ func resourceAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAccessKeyReadResult(d *schema.ResourceData, key *iam.AccessKeyMetadata) error {
-	d.SetId(aws.StringValue(key.AccessKeyId))
+	d.Set("access_key_id", key.AccessKeyId)
 
 	if key.CreateDate != nil {
 		d.Set("create_date", aws.TimeValue(key.CreateDate).Format(time.RFC3339))
@@ -223,8 +296,29 @@ func resourceAccessKeyReadResult(d *schema.ResourceData, key *iam.AccessKeyMetad
 func resourceAccessKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).IAMConn
 
+	// Rotation and removal of the previous key are decided at plan time by CustomizeDiff.
+	// The attributes it plans are unknown or empty in the plan, so the current values come from prior state.
+	id, _ := d.GetChange("access_key_id")
+	currentID := id.(string)
+
+	if currentID == "" {
+		currentID = d.Id()
+	}
+
+	previousID, _ := d.GetChange("previous_access_key_id")
+
+	if accessKeyRotationPlanned(d.GetRawPlan()) {
+		return resourceAccessKeyRotate(d, meta, currentID, previousID.(string))
+	}
+
 	if d.HasChange("status") {
-		if err := resourceAccessKeyStatusUpdate(conn, d); err != nil {
+		if err := resourceAccessKeyStatusUpdate(conn, d, currentID); err != nil {
+			return err
+		}
+	}
+
+	if previousID.(string) != "" && d.Get("previous_access_key_id").(string) == "" {
+		if err := deleteAccessKey(conn, previousID.(string), d.Get("user").(string)); err != nil {
 			return err
 		}
 	}
@@ -235,26 +329,194 @@ func resourceAccessKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).IAMConn
 
+	if v := d.Get("previous_access_key_id").(string); v != "" {
+		if err := deleteAccessKey(conn, v, d.Get("user").(string)); err != nil {
+			return err
+		}
+	}
+
+	return deleteAccessKey(conn, accessKeyID(d), d.Get("user").(string))
+}
+
+// resourceAccessKeyRotate replaces the current access key with a new one and deactivates the
+// old key, which is deleted once its grace period has elapsed.
+func resourceAccessKeyRotate(d *schema.ResourceData, meta interface{}, currentID, previousID string) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	userName := d.Get("user").(string)
+
+	// A user can have at most two access keys, so remove any key left over from an earlier rotation.
+	if previousID != "" {
+		if err := deleteAccessKey(conn, previousID, userName); err != nil {
+			return err
+		}
+	}
+
+	accessKey, err := createAccessKey(d, meta)
+
+	if err != nil {
+		return fmt.Errorf("error rotating IAM Access Key (%s): %w", currentID, err)
+	}
+
+	newID := aws.StringValue(accessKey.AccessKeyId)
+
+	_, err = conn.UpdateAccessKey(&iam.UpdateAccessKeyInput{
+		AccessKeyId: aws.String(currentID),
+		Status:      aws.String(iam.StatusTypeInactive),
+		UserName:    aws.String(userName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error deactivating rotated IAM Access Key (%s): %w", currentID, err)
+	}
+
+	// The resource ID follows the active key, so that imports and references never use a deleted key.
+	d.SetId(newID)
+	d.Set("access_key_id", newID)
+	d.Set("previous_access_key_deactivation_date", time.Now().UTC().Format(time.RFC3339))
+	d.Set("previous_access_key_id", currentID)
+
+	if d.Get("status").(string) == iam.StatusTypeInactive {
+		if err := resourceAccessKeyStatusUpdate(conn, d, newID); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[INFO] Rotated IAM Access Key (%s) to (%s)", currentID, newID)
+
+	return resourceAccessKeyRead(d, meta)
+}
+
+func resourceAccessKeyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Nothing to rotate until the key exists.
+	if diff.Id() == "" {
+		return nil
+	}
+
+	now := time.Now()
+	rotation := diff.Get("rotation").([]interface{})
+
+	if accessKeyRotationDue(rotation, diff.Get("create_date").(string), now) {
+		for _, k := range []string{"access_key_id", "create_date", "encrypted_secret", "encrypted_ses_smtp_password_v4", "key_fingerprint", "previous_access_key_deactivation_date", "previous_access_key_id", "secret", "ses_smtp_password_v4"} {
+			if err := diff.SetNewComputed(k); err != nil {
+				return fmt.Errorf("error setting %s to unknown: %w", k, err)
+			}
+		}
+
+		return nil
+	}
+
+	if diff.Get("previous_access_key_id").(string) != "" && accessKeyPreviousKeyExpired(rotation, diff.Get("previous_access_key_deactivation_date").(string), now) {
+		for _, k := range []string{"previous_access_key_deactivation_date", "previous_access_key_id"} {
+			if err := diff.SetNew(k, ""); err != nil {
+				return fmt.Errorf("error setting %s: %w", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// accessKeyRotationPlanned returns whether CustomizeDiff planned a rotation, which it marks by
+// setting access_key_id to unknown.
+func accessKeyRotationPlanned(rawPlan cty.Value) bool {
+	if !rawPlan.IsKnown() || rawPlan.IsNull() || !rawPlan.Type().IsObjectType() || !rawPlan.Type().HasAttribute("access_key_id") {
+		return false
+	}
+
+	return !rawPlan.GetAttr("access_key_id").IsKnown()
+}
+
+// accessKeyID returns the ID of the current access key.
+// State written by earlier versions may still have the original key as the resource ID after a rotation.
+func accessKeyID(d *schema.ResourceData) string {
+	if v := d.Get("access_key_id").(string); v != "" {
+		return v
+	}
+
+	return d.Id()
+}
+
+// accessKeyRotationDue returns whether the configured rotation policy requires a new access key.
+func accessKeyRotationDue(rotation []interface{}, createDate string, now time.Time) bool {
+	rotateAfter, _, ok := expandAccessKeyRotation(rotation)
+
+	if !ok {
+		return false
+	}
+
+	created, err := time.Parse(time.RFC3339, createDate)
+
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(created.Add(rotateAfter))
+}
+
+// accessKeyPreviousKeyExpired returns whether the deactivated key from the last rotation has outlived its grace period.
+func accessKeyPreviousKeyExpired(rotation []interface{}, deactivationDate string, now time.Time) bool {
+	gracePeriod := time.Duration(0)
+
+	if _, v, ok := expandAccessKeyRotation(rotation); ok {
+		gracePeriod = v
+	}
+
+	deactivated, err := time.Parse(time.RFC3339, deactivationDate)
+
+	if err != nil {
+		return true
+	}
+
+	return !now.Before(deactivated.Add(gracePeriod))
+}
+
+func expandAccessKeyRotation(l []interface{}) (time.Duration, time.Duration, bool) {
+	if len(l) == 0 || l[0] == nil {
+		return 0, 0, false
+	}
+
+	m := l[0].(map[string]interface{})
+
+	rotateAfter, err := time.ParseDuration(m["rotate_after"].(string))
+
+	if err != nil {
+		return 0, 0, false
+	}
+
+	gracePeriod, _ := time.ParseDuration(m["deactivation_grace_period"].(string))
+
+	return rotateAfter, gracePeriod, true
+}
+
+func deleteAccessKey(conn *iam.IAM, id, userName string) error {
 	request := &iam.DeleteAccessKeyInput{
-		AccessKeyId: aws.String(d.Id()),
-		UserName:    aws.String(d.Get("user").(string)),
+		AccessKeyId: aws.String(id),
+		UserName:    aws.String(userName),
 	}
 
-	if _, err := conn.DeleteAccessKey(request); err != nil {
-		return fmt.Errorf("Error deleting access key %s: %s", d.Id(), err)
+	_, err := conn.DeleteAccessKey(request)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
 	}
+
+	if err != nil {
+		return fmt.Errorf("Error deleting access key %s: %s", id, err)
+	}
+
 	return nil
 }
 
-func resourceAccessKeyStatusUpdate(conn *iam.IAM, d *schema.ResourceData) error {
+func resourceAccessKeyStatusUpdate(conn *iam.IAM, d *schema.ResourceData, id string) error {
 	request := &iam.UpdateAccessKeyInput{
-		AccessKeyId: aws.String(d.Id()),
+		AccessKeyId: aws.String(id),
 		Status:      aws.String(d.Get("status").(string)),
 		UserName:    aws.String(d.Get("user").(string)),
 	}
 
 	if _, err := conn.UpdateAccessKey(request); err != nil {
-		return fmt.Errorf("Error updating access key %s: %s", d.Id(), err)
+		return fmt.Errorf("Error updating access key %s: %s", id, err)
 	}
 	return nil
 }
@@ -35,6 +35,7 @@ func TestAccIAMAccessKey_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAccessKeyExists(resourceName, &conf),
 					testAccCheckAccessKeyAttributes(&conf, "Active"),
+					resource.TestCheckResourceAttrPair(resourceName, "access_key_id", resourceName, "id"),
 					acctest.CheckResourceAttrRFC3339(resourceName, "create_date"),
 					resource.TestCheckResourceAttrSet(resourceName, "secret"),
 					resource.TestCheckNoResourceAttr(resourceName, "encrypted_secret"),
@@ -129,6 +130,44 @@ func TestAccIAMAccessKey_status(t *testing.T) {
 	})
 }
 
+func TestAccIAMAccessKey_rotation(t *testing.T) {
+	var conf iam.AccessKeyMetadata
+	resourceName := "aws_iam_access_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessKeyConfig_rotation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessKeyExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "rotation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.rotate_after", "1s"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.deactivation_grace_period", "1h"),
+					resource.TestCheckResourceAttrPair(resourceName, "access_key_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "previous_access_key_id", ""),
+				),
+				// The key is due for rotation as soon as it has been created.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAccessKeyConfig_rotation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessKeyRotated(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "status", iam.StatusTypeActive),
+					resource.TestCheckResourceAttrSet(resourceName, "secret"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "previous_access_key_deactivation_date"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAccessKeyDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
 
@@ -193,6 +232,51 @@ func testAccCheckAccessKeyExists(n string, res *iam.AccessKeyMetadata) resource.
 	}
 }
 
+func testAccCheckAccessKeyRotated(n string, previous *iam.AccessKeyMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		previousID := aws.StringValue(previous.AccessKeyId)
+
+		if rs.Primary.Attributes["access_key_id"] == previousID {
+			return fmt.Errorf("IAM Access Key (%s) was not rotated", previousID)
+		}
+
+		if v := rs.Primary.Attributes["access_key_id"]; rs.Primary.ID != v {
+			return fmt.Errorf("expected resource ID to be the active key %q, got %q", v, rs.Primary.ID)
+		}
+
+		if v := rs.Primary.Attributes["previous_access_key_id"]; v != previousID {
+			return fmt.Errorf("expected previous_access_key_id %q, got %q", previousID, v)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+
+		resp, err := conn.ListAccessKeys(&iam.ListAccessKeysInput{
+			UserName: aws.String(rs.Primary.Attributes["user"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, key := range resp.AccessKeyMetadata {
+			if aws.StringValue(key.AccessKeyId) == previousID {
+				if status := aws.StringValue(key.Status); status != iam.StatusTypeInactive {
+					return fmt.Errorf("expected rotated IAM Access Key (%s) to be %s, got %s", previousID, iam.StatusTypeInactive, status)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("rotated IAM Access Key (%s) not found", previousID)
+	}
+}
+
 func testAccCheckAccessKeyAttributes(accessKeyMetadata *iam.AccessKeyMetadata, status string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if !strings.Contains(*accessKeyMetadata.UserName, acctest.ResourcePrefix) {
@@ -280,6 +364,23 @@ resource "aws_iam_access_key" "test" {
 `, rName, status)
 }
 
+func testAccAccessKeyConfig_rotation(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_access_key" "test" {
+  user = aws_iam_user.test.name
+
+  rotation {
+    rotate_after              = "1s"
+    deactivation_grace_period = "1h"
+  }
+}
+`, rName)
+}
+
 func TestSesSmtpPasswordFromSecretKeySigV4(t *testing.T) {
 	cases := []struct {
 		Region   string
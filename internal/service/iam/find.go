@@ -201,6 +201,46 @@ func FindVirtualMfaDevice(conn *iam.IAM, serialNum string) (*iam.VirtualMFADevic
 	return device, nil
 }
 
+func FindSigningCertificate(conn *iam.IAM, userName, certID string) (*iam.SigningCertificate, error) {
+	input := &iam.ListSigningCertificatesInput{
+		UserName: aws.String(userName),
+	}
+
+	var cert *iam.SigningCertificate
+
+	err := conn.ListSigningCertificatesPages(input, func(page *iam.ListSigningCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Certificates {
+			if v != nil && aws.StringValue(v.CertificateId) == certID {
+				cert = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cert == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return cert, nil
+}
+
 func FindServiceSpecificCredential(conn *iam.IAM, serviceName, userName, credID string) (*iam.ServiceSpecificCredentialMetadata, error) {
 	input := &iam.ListServiceSpecificCredentialsInput{
 		ServiceName: aws.String(serviceName),
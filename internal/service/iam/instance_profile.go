@@ -233,13 +233,16 @@ func resourceInstanceProfileRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		_, err := conn.GetRole(input)
-		if err != nil {
-			if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
-				err := instanceProfileRemoveRole(conn, d.Id(), roleName)
-				if err != nil {
-					return fmt.Errorf("removing role %s to IAM instance profile %s: %w", roleName, d.Id(), err)
-				}
+		if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
+			// The role was deleted outside Terraform; detach it so that the
+			// difference is reported and the configured role can be re-added in place.
+			err := instanceProfileRemoveRole(conn, d.Id(), roleName)
+			if err != nil {
+				return fmt.Errorf("removing role %s to IAM instance profile %s: %w", roleName, d.Id(), err)
 			}
+
+			instanceProfile.Roles = nil
+		} else if err != nil {
 			return fmt.Errorf("reading IAM Role %s attached to IAM Instance Profile %s: %w", roleName, d.Id(), err)
 		}
 	}
@@ -289,6 +292,8 @@ func instanceProfileReadResult(d *schema.ResourceData, result *iam.InstanceProfi
 
 	if result.Roles != nil && len(result.Roles) > 0 {
 		d.Set("role", result.Roles[0].RoleName) //there will only be 1 role returned
+	} else {
+		d.Set("role", nil)
 	}
 
 	tags := KeyValueTags(result.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
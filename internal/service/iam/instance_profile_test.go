@@ -193,6 +193,42 @@ func TestAccIAMInstanceProfile_Disappears_role(t *testing.T) {
 	})
 }
 
+func TestAccIAMInstanceProfile_roleUpdate(t *testing.T) {
+	var before, after iam.GetInstanceProfileOutput
+	resourceName := "aws_iam_instance_profile.test"
+	rName := sdkacctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceProfileRoleUpdateConfig(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceProfileExists(resourceName, &before),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.test", "name"),
+				),
+			},
+			{
+				Config: testAccInstanceProfileRoleUpdateConfig(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceProfileExists(resourceName, &after),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "aws_iam_role.test2", "name"),
+					func(*terraform.State) error {
+						if aws.StringValue(before.InstanceProfile.InstanceProfileId) != aws.StringValue(after.InstanceProfile.InstanceProfileId) {
+							return fmt.Errorf("IAM Instance Profile was recreated")
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceProfileGeneratedNamePrefix(resource, prefix string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		r, ok := s.RootModule().Resources[resource]
@@ -341,3 +377,17 @@ resource "aws_iam_instance_profile" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccInstanceProfileRoleUpdateConfig(rName, roleResourceName string) string {
+	return testAccInstanceProfileBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_iam_role" "test2" {
+  name               = "test2-%[1]s"
+  assume_role_policy = aws_iam_role.test.assume_role_policy
+}
+
+resource "aws_iam_instance_profile" "test" {
+  name = "test-%[1]s"
+  role = aws_iam_role.%[2]s.name
+}
+`, rName, roleResourceName)
+}
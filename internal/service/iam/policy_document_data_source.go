@@ -1,13 +1,16 @@
 package iam
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -15,6 +18,21 @@ import (
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+const (
+	// policyDocumentManagedPolicyMaxSize is the maximum size, in non-whitespace characters, of a managed policy document.
+	policyDocumentManagedPolicyMaxSize = 6144
+)
+
+// policyConditionOperatorRegexp matches IAM condition operators, including the
+// ForAllValues/ForAnyValue set operator prefixes and the IfExists suffix.
+// Operators are matched case-insensitively, as IAM does.
+var policyConditionOperatorRegexp = regexp.MustCompile(`(?i)^(?:(?:ForAllValues|ForAnyValue):)?(?:(?:` +
+	`String(?:Not)?Equals(?:IgnoreCase)?|String(?:Not)?Like|` +
+	`Numeric(?:(?:Not)?Equals|(?:Less|Greater)Than(?:Equals)?)|` +
+	`Date(?:(?:Not)?Equals|(?:Less|Greater)Than(?:Equals)?)|` +
+	`Bool|BinaryEquals|(?:Not)?IpAddress|Arn(?:Not)?(?:Equals|Like)` +
+	`)(?:IfExists)?|Null)$`)
+
 func DataSourcePolicyDocument() *schema.Resource {
 	setOfString := &schema.Schema{
 		Type:     schema.TypeSet,
@@ -25,13 +43,17 @@ func DataSourcePolicyDocument() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Read: dataSourcePolicyDocumentRead,
+		ReadWithoutTimeout: dataSourcePolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"minified_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"override_json": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -68,8 +90,9 @@ func DataSourcePolicyDocument() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"test": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(policyConditionOperatorRegexp, "must be a valid IAM condition operator, optionally prefixed with ForAllValues: or ForAnyValue: and suffixed with IfExists"),
 									},
 									"values": {
 										Type:     schema.TypeList,
@@ -116,7 +139,28 @@ func DataSourcePolicyDocument() *schema.Resource {
 	}
 }
 
-func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := dataSourcePolicyDocumentBuild(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Managed policies have the strictest size quota. Other policy types
+	// (inline, trust, resource-based) allow larger documents, so exceeding
+	// it is only a warning.
+	if n := len(d.Get("minified_json").(string)); n > policyDocumentManagedPolicyMaxSize {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Policy document exceeds the IAM managed policy size quota",
+				Detail:   fmt.Sprintf("The minified policy document is %d characters long, which exceeds the %d character quota for IAM managed policies. Using it as an aws_iam_policy will fail at apply time.", n, policyDocumentManagedPolicyMaxSize),
+			},
+		}
+	}
+
+	return nil
+}
+
+func dataSourcePolicyDocumentBuild(d *schema.ResourceData) error {
 	mergedDoc := &IAMPolicyDoc{}
 
 	if v, ok := d.GetOk("source_json"); ok {
@@ -276,7 +320,13 @@ func dataSourcePolicyDocumentRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	jsonString := string(jsonDoc)
 
+	minifiedJSONDoc, err := json.Marshal(mergedDoc)
+	if err != nil {
+		return err
+	}
+
 	d.Set("json", jsonString)
+	d.Set("minified_json", string(minifiedJSONDoc))
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return nil
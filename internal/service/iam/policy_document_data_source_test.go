@@ -44,6 +44,7 @@ func TestAccIAMPolicyDocumentDataSource_singleConditionValue(t *testing.T) {
 				Config: testAccPolicyDocumentConfig_SingleConditionValue,
 				Check: resource.ComposeTestCheckFunc(
 					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccPolicyDocumentConfig_SingleConditionValue_ExpectedJSON),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "minified_json", testAccPolicyDocumentConfig_SingleConditionValue_ExpectedJSON),
 				),
 			},
 		},
@@ -201,6 +202,20 @@ func TestAccIAMPolicyDocumentDataSource_noStatementOverride(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_invalidConditionOperator(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentInvalidConditionOperatorConfig,
+				ExpectError: regexp.MustCompile(`must be a valid IAM condition operator`),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_duplicateSid(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -1320,3 +1335,18 @@ func testAccPolicyDocumentExpectedJSONStatementPrincipalIdentifiersMultiplePrinc
   ]
 }`, acctest.Partition())
 }
+
+const testAccPolicyDocumentInvalidConditionOperatorConfig = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+
+    condition {
+      test     = "StringEqualz"
+      variable = "aws:PrincipalTag/team"
+      values   = ["example"]
+    }
+  }
+}
+`
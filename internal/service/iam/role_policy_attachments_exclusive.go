@@ -0,0 +1,115 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRolePolicyAttachmentsExclusivePut,
+		Read:   resourceRolePolicyAttachmentsExclusiveRead,
+		Update: resourceRolePolicyAttachmentsExclusivePut,
+		Delete: resourceRolePolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("role_name", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePolicyAttachmentsExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	roleName := d.Get("role_name").(string)
+
+	// Reconcile against the attachments that actually exist so that
+	// policies attached outside of Terraform are also removed.
+	attached, err := readRolePolicyAttachments(conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) policy attachments: %w", roleName, err)
+	}
+
+	os := flex.FlattenStringSet(attached)
+	ns := d.Get("policy_arns").(*schema.Set)
+	remove := flex.ExpandStringSet(os.Difference(ns))
+	add := flex.ExpandStringSet(ns.Difference(os))
+
+	if err := deleteRolePolicyAttachments(conn, roleName, remove); err != nil {
+		return fmt.Errorf("error detaching policies from IAM Role (%s): %w", roleName, err)
+	}
+
+	if err := addRoleManagedPolicies(roleName, add, meta); err != nil {
+		return fmt.Errorf("error attaching policies to IAM Role (%s): %w", roleName, err)
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePolicyAttachmentsExclusiveRead(d, meta)
+}
+
+func resourceRolePolicyAttachmentsExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	_, err := FindRoleByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing policy attachments exclusive from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s): %w", d.Id(), err)
+	}
+
+	attached, err := readRolePolicyAttachments(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) policy attachments: %w", d.Id(), err)
+	}
+
+	d.Set("role_name", d.Id())
+
+	if err := d.Set("policy_arns", aws.StringValueSlice(attached)); err != nil {
+		return fmt.Errorf("error setting policy_arns: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRolePolicyAttachmentsExclusiveDelete(d *schema.ResourceData, meta interface{}) error {
+	// Destroying this resource only stops Terraform from enforcing the
+	// exclusive set of attachments; the policies remain attached to the role.
+	log.Printf("[DEBUG] Removing IAM Role (%s) policy attachments exclusive from state; attached policies are left in place", d.Id())
+
+	return nil
+}
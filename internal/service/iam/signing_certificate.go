@@ -0,0 +1,177 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSigningCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSigningCertificateCreate,
+		Read:   resourceSigningCertificateRead,
+		Update: resourceSigningCertificateUpdate,
+		Delete: resourceSigningCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressNormalizeCertRemoval,
+			},
+			"certificate_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      iam.StatusTypeActive,
+				ValidateFunc: validation.StringInSlice(iam.StatusType_Values(), false),
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceSigningCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	input := &iam.UploadSigningCertificateInput{
+		CertificateBody: aws.String(d.Get("certificate_body").(string)),
+		UserName:        aws.String(d.Get("user_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Uploading IAM Signing Certificate: %s", input)
+	output, err := conn.UploadSigningCertificate(input)
+
+	if err != nil {
+		return fmt.Errorf("error uploading IAM Signing Certificate: %w", err)
+	}
+
+	cert := output.Certificate
+
+	d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(cert.CertificateId), aws.StringValue(cert.UserName)))
+
+	if v, ok := d.GetOk("status"); ok && v.(string) != iam.StatusTypeActive {
+		input := &iam.UpdateSigningCertificateInput{
+			CertificateId: cert.CertificateId,
+			Status:        aws.String(v.(string)),
+			UserName:      cert.UserName,
+		}
+
+		if _, err := conn.UpdateSigningCertificate(input); err != nil {
+			return fmt.Errorf("error setting IAM Signing Certificate (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSigningCertificateRead(d, meta)
+}
+
+func resourceSigningCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	certID, userName, err := DecodeSigningCertificateId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindSigningCertificate(conn, userName, certID)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Signing Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Signing Certificate (%s): %w", d.Id(), err)
+	}
+
+	cert := outputRaw.(*iam.SigningCertificate)
+
+	d.Set("certificate_body", cert.CertificateBody)
+	d.Set("certificate_id", cert.CertificateId)
+	d.Set("status", cert.Status)
+	d.Set("user_name", cert.UserName)
+
+	return nil
+}
+
+func resourceSigningCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	certID, userName, err := DecodeSigningCertificateId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &iam.UpdateSigningCertificateInput{
+		CertificateId: aws.String(certID),
+		Status:        aws.String(d.Get("status").(string)),
+		UserName:      aws.String(userName),
+	}
+
+	if _, err := conn.UpdateSigningCertificate(input); err != nil {
+		return fmt.Errorf("error updating IAM Signing Certificate (%s): %w", d.Id(), err)
+	}
+
+	return resourceSigningCertificateRead(d, meta)
+}
+
+func resourceSigningCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	certID, userName, err := DecodeSigningCertificateId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting IAM Signing Certificate: %s", d.Id())
+	_, err = conn.DeleteSigningCertificate(&iam.DeleteSigningCertificateInput{
+		CertificateId: aws.String(certID),
+		UserName:      aws.String(userName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Signing Certificate (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func DecodeSigningCertificateId(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected certificate_id:user_name", id)
+	}
+
+	return parts[0], parts[1], nil
+}
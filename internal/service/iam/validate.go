@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 )
 
 func validRolePolicyName(v interface{}, k string) (ws []string, errors []error) {
@@ -65,3 +66,18 @@ func validOpenIDURL(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+func validAccessKeyRotationDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+	}
+
+	return
+}
@@ -114,3 +114,39 @@ func TestValidOpenIDURL(t *testing.T) {
 		}
 	}
 }
+
+func TestValidAccessKeyRotationDuration(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "2160h",
+			ErrCount: 0,
+		},
+		{
+			Value:    "30m",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0s",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-1h",
+			ErrCount: 1,
+		},
+		{
+			Value:    "90d",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validAccessKeyRotationDuration(tc.Value, "rotate_after")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d of access key rotation duration validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package kinesis
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+)
+
+func FindOpenShardsByStreamName(conn *kinesis.Kinesis, name string) ([]*kinesis.Shard, error) {
+	input := &kinesis.ListShardsInput{
+		ShardFilter: &kinesis.ShardFilter{
+			Type: aws.String(kinesis.ShardFilterTypeAtLatest),
+		},
+		StreamName: aws.String(name),
+	}
+	var output []*kinesis.Shard
+
+	for {
+		page, err := conn.ListShards(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Shards {
+			if v != nil && v.SequenceNumberRange != nil && v.SequenceNumberRange.EndingSequenceNumber == nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		// StreamName and ShardFilter must not be specified with NextToken.
+		input = &kinesis.ListShardsInput{
+			NextToken: page.NextToken,
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,171 @@
+package kinesis
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+)
+
+const (
+	// ShardCountUpdateStrategySplitMerge reshards a stream by splitting or merging individual shards.
+	// It is not a Kinesis API scaling type.
+	ShardCountUpdateStrategySplitMerge = "SPLIT_MERGE"
+)
+
+func ShardCountUpdateStrategy_Values() []string {
+	return append(kinesis.ScalingType_Values(), ShardCountUpdateStrategySplitMerge)
+}
+
+type shardHashKeyRange struct {
+	shardID string
+	start   *big.Int
+	end     *big.Int
+}
+
+func (r shardHashKeyRange) width() *big.Int {
+	return new(big.Int).Sub(r.end, r.start)
+}
+
+// updateShardCountBySplitMerge splits or merges open shards one at a time until the stream has `target` open shards.
+// Shards with the widest hash key ranges are split first and adjacent shards with the narrowest combined hash key range are merged first,
+// keeping the hash key space evenly distributed.
+// The whole resharding, not each individual split or merge, is bounded by `timeout`.
+func updateShardCountBySplitMerge(conn *kinesis.Kinesis, name string, target int, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		shards, err := FindOpenShardsByStreamName(conn, name)
+
+		if err != nil {
+			return fmt.Errorf("error listing shards: %w", err)
+		}
+
+		ranges, err := expandShardHashKeyRanges(shards)
+
+		if err != nil {
+			return err
+		}
+
+		switch count := len(ranges); {
+		case count < target:
+			shardID, newStartingHashKey := shardToSplit(ranges)
+			input := &kinesis.SplitShardInput{
+				NewStartingHashKey: aws.String(newStartingHashKey.String()),
+				ShardToSplit:       aws.String(shardID),
+				StreamName:         aws.String(name),
+			}
+
+			log.Printf("[DEBUG] Splitting Kinesis Stream shard: %s", input)
+			if _, err := conn.SplitShard(input); err != nil {
+				return fmt.Errorf("error splitting shard (%s): %w", shardID, err)
+			}
+
+		case count > target:
+			shardID, adjacentShardID, ok := shardsToMerge(ranges)
+
+			if !ok {
+				return fmt.Errorf("no adjacent shards to merge")
+			}
+
+			input := &kinesis.MergeShardsInput{
+				AdjacentShardToMerge: aws.String(adjacentShardID),
+				ShardToMerge:         aws.String(shardID),
+				StreamName:           aws.String(name),
+			}
+
+			log.Printf("[DEBUG] Merging Kinesis Stream shards: %s", input)
+			if _, err := conn.MergeShards(input); err != nil {
+				return fmt.Errorf("error merging shards (%s, %s): %w", shardID, adjacentShardID, err)
+			}
+
+		default:
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+
+		if remaining <= 0 {
+			return fmt.Errorf("timeout while waiting for stream to have %d open shards", target)
+		}
+
+		if _, err := waitStreamUpdated(conn, name, remaining); err != nil {
+			return fmt.Errorf("error waiting for update: %w", err)
+		}
+	}
+}
+
+func expandShardHashKeyRanges(shards []*kinesis.Shard) ([]shardHashKeyRange, error) {
+	ranges := make([]shardHashKeyRange, 0, len(shards))
+
+	for _, shard := range shards {
+		if shard.HashKeyRange == nil {
+			continue
+		}
+
+		start, ok := new(big.Int).SetString(aws.StringValue(shard.HashKeyRange.StartingHashKey), 10)
+
+		if !ok {
+			return nil, fmt.Errorf("invalid starting hash key for shard (%s): %s", aws.StringValue(shard.ShardId), aws.StringValue(shard.HashKeyRange.StartingHashKey))
+		}
+
+		end, ok := new(big.Int).SetString(aws.StringValue(shard.HashKeyRange.EndingHashKey), 10)
+
+		if !ok {
+			return nil, fmt.Errorf("invalid ending hash key for shard (%s): %s", aws.StringValue(shard.ShardId), aws.StringValue(shard.HashKeyRange.EndingHashKey))
+		}
+
+		ranges = append(ranges, shardHashKeyRange{
+			shardID: aws.StringValue(shard.ShardId),
+			start:   start,
+			end:     end,
+		})
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].start.Cmp(ranges[j].start) < 0
+	})
+
+	return ranges, nil
+}
+
+// shardToSplit returns the ID of the shard with the widest hash key range and the hash key at which to split it in half.
+func shardToSplit(ranges []shardHashKeyRange) (string, *big.Int) {
+	var widest shardHashKeyRange
+
+	for i, r := range ranges {
+		if i == 0 || r.width().Cmp(widest.width()) > 0 {
+			widest = r
+		}
+	}
+
+	mid := new(big.Int).Rsh(widest.width(), 1)
+	mid.Add(mid, widest.start)
+	mid.Add(mid, big.NewInt(1))
+
+	return widest.shardID, mid
+}
+
+// shardsToMerge returns the IDs of the pair of adjacent shards with the narrowest combined hash key range.
+func shardsToMerge(ranges []shardHashKeyRange) (string, string, bool) {
+	var shardID, adjacentShardID string
+	var narrowest *big.Int
+
+	for i := 1; i < len(ranges); i++ {
+		previous, current := ranges[i-1], ranges[i]
+
+		if new(big.Int).Add(previous.end, big.NewInt(1)).Cmp(current.start) != 0 {
+			continue
+		}
+
+		if width := new(big.Int).Sub(current.end, previous.start); narrowest == nil || width.Cmp(narrowest) < 0 {
+			shardID, adjacentShardID, narrowest = previous.shardID, current.shardID, width
+		}
+	}
+
+	return shardID, adjacentShardID, narrowest != nil
+}
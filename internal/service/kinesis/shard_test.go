@@ -0,0 +1,127 @@
+package kinesis
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+)
+
+const maxHashKey = "340282366920938463463374607431768211455"
+
+func testShard(id, start, end string) *kinesis.Shard {
+	return &kinesis.Shard{
+		ShardId: aws.String(id),
+		HashKeyRange: &kinesis.HashKeyRange{
+			StartingHashKey: aws.String(start),
+			EndingHashKey:   aws.String(end),
+		},
+	}
+}
+
+func TestShardToSplit(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Shards             []*kinesis.Shard
+		ExpectedShardID    string
+		ExpectedSplitPoint string
+	}{
+		{
+			Name: "single shard",
+			Shards: []*kinesis.Shard{
+				testShard("shardId-000000000000", "0", maxHashKey),
+			},
+			ExpectedShardID:    "shardId-000000000000",
+			ExpectedSplitPoint: "170141183460469231731687303715884105728",
+		},
+		{
+			Name: "uneven shards",
+			Shards: []*kinesis.Shard{
+				testShard("shardId-000000000001", "0", "99"),
+				testShard("shardId-000000000002", "100", "149"),
+				testShard("shardId-000000000003", "150", "199"),
+			},
+			ExpectedShardID:    "shardId-000000000001",
+			ExpectedSplitPoint: "50",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ranges, err := expandShardHashKeyRanges(testCase.Shards)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			shardID, splitPoint := shardToSplit(ranges)
+
+			if shardID != testCase.ExpectedShardID {
+				t.Errorf("got shard %s, expected %s", shardID, testCase.ExpectedShardID)
+			}
+
+			if got := splitPoint.String(); got != testCase.ExpectedSplitPoint {
+				t.Errorf("got split point %s, expected %s", got, testCase.ExpectedSplitPoint)
+			}
+		})
+	}
+}
+
+func TestShardsToMerge(t *testing.T) {
+	testCases := []struct {
+		Name                    string
+		Shards                  []*kinesis.Shard
+		ExpectedOK              bool
+		ExpectedShardID         string
+		ExpectedAdjacentShardID string
+	}{
+		{
+			Name: "single shard",
+			Shards: []*kinesis.Shard{
+				testShard("shardId-000000000000", "0", maxHashKey),
+			},
+		},
+		{
+			Name: "narrowest adjacent pair",
+			Shards: []*kinesis.Shard{
+				testShard("shardId-000000000003", "150", "199"),
+				testShard("shardId-000000000001", "0", "99"),
+				testShard("shardId-000000000002", "100", "149"),
+			},
+			ExpectedOK:              true,
+			ExpectedShardID:         "shardId-000000000002",
+			ExpectedAdjacentShardID: "shardId-000000000003",
+		},
+		{
+			Name: "non-adjacent shards",
+			Shards: []*kinesis.Shard{
+				testShard("shardId-000000000001", "0", "99"),
+				testShard("shardId-000000000003", "150", "199"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ranges, err := expandShardHashKeyRanges(testCase.Shards)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			shardID, adjacentShardID, ok := shardsToMerge(ranges)
+
+			if ok != testCase.ExpectedOK {
+				t.Fatalf("got %t, expected %t", ok, testCase.ExpectedOK)
+			}
+
+			if shardID != testCase.ExpectedShardID {
+				t.Errorf("got shard %s, expected %s", shardID, testCase.ExpectedShardID)
+			}
+
+			if adjacentShardID != testCase.ExpectedAdjacentShardID {
+				t.Errorf("got adjacent shard %s, expected %s", adjacentShardID, testCase.ExpectedAdjacentShardID)
+			}
+		})
+	}
+}
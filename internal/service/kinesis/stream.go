@@ -110,6 +110,11 @@ func ResourceStream() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"shard_count_update_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ShardCountUpdateStrategy_Values(), false),
+			},
 			"shard_level_metrics": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -349,23 +354,32 @@ func resourceStreamUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if streamMode := getStreamMode(d); streamMode == kinesis.StreamModeProvisioned && d.HasChange("shard_count") {
-		input := &kinesis.UpdateShardCountInput{
-			ScalingType:      aws.String(kinesis.ScalingTypeUniformScaling),
-			StreamName:       aws.String(name),
-			TargetShardCount: aws.Int64(int64(d.Get("shard_count").(int))),
-		}
+		shardCount := d.Get("shard_count").(int)
 
-		log.Printf("[DEBUG] Updating Kinesis Stream shard count: %s", input)
-		_, err := conn.UpdateShardCount(input)
+		switch d.Get("shard_count_update_strategy").(string) {
+		case ShardCountUpdateStrategySplitMerge:
+			if err := updateShardCountBySplitMerge(conn, name, shardCount, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error updating Kinesis Stream (%s) shard count: %w", name, err)
+			}
+		default:
+			input := &kinesis.UpdateShardCountInput{
+				ScalingType:      aws.String(kinesis.ScalingTypeUniformScaling),
+				StreamName:       aws.String(name),
+				TargetShardCount: aws.Int64(int64(shardCount)),
+			}
 
-		if err != nil {
-			return fmt.Errorf("error updating Kinesis Stream (%s) shard count: %w", name, err)
-		}
+			log.Printf("[DEBUG] Updating Kinesis Stream shard count: %s", input)
+			_, err := conn.UpdateShardCount(input)
 
-		_, err = waitStreamUpdated(conn, name, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return fmt.Errorf("error updating Kinesis Stream (%s) shard count: %w", name, err)
+			}
 
-		if err != nil {
-			return fmt.Errorf("error waiting for Kinesis Stream (%s) update (UpdateShardCount): %w", name, err)
+			_, err = waitStreamUpdated(conn, name, d.Timeout(schema.TimeoutUpdate))
+
+			if err != nil {
+				return fmt.Errorf("error waiting for Kinesis Stream (%s) update (UpdateShardCount): %w", name, err)
+			}
 		}
 	}
 
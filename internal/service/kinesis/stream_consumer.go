@@ -25,6 +25,11 @@ func ResourceStreamConsumer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(streamConsumerCreatedTimeout),
+			Delete: schema.DefaultTimeout(streamConsumerDeletedTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -59,15 +64,18 @@ func resourceStreamConsumerCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	log.Printf("[DEBUG] Registering Kinesis Stream Consumer: %s", input)
-	output, err := conn.RegisterStreamConsumer(input)
+	// Consumers cannot be registered while the stream is being created or resharded.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.RegisterStreamConsumer(input)
+	}, kinesis.ErrCodeResourceInUseException)
 
 	if err != nil {
 		return fmt.Errorf("error creating Kinesis Stream Consumer (%s): %w", name, err)
 	}
 
-	d.SetId(aws.StringValue(output.Consumer.ConsumerARN))
+	d.SetId(aws.StringValue(outputRaw.(*kinesis.RegisterStreamConsumerOutput).Consumer.ConsumerARN))
 
-	if _, err := waitStreamConsumerCreated(conn, d.Id()); err != nil {
+	if _, err := waitStreamConsumerCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("error waiting for Kinesis Stream Consumer (%s) create: %w", d.Id(), err)
 	}
 
@@ -113,7 +121,7 @@ func resourceStreamConsumerDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error deleting Kinesis Stream Consumer (%s): %w", d.Id(), err)
 	}
 
-	if _, err := waitStreamConsumerDeleted(conn, d.Id()); err != nil {
+	if _, err := waitStreamConsumerDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return fmt.Errorf("error waiting for Kinesis Stream Consumer (%s) delete: %w", d.Id(), err)
 	}
 
@@ -166,12 +174,12 @@ const (
 	streamConsumerDeletedTimeout = 5 * time.Minute
 )
 
-func waitStreamConsumerCreated(conn *kinesis.Kinesis, arn string) (*kinesis.ConsumerDescription, error) {
+func waitStreamConsumerCreated(conn *kinesis.Kinesis, arn string, timeout time.Duration) (*kinesis.ConsumerDescription, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{kinesis.ConsumerStatusCreating},
 		Target:  []string{kinesis.ConsumerStatusActive},
 		Refresh: statusStreamConsumer(conn, arn),
-		Timeout: streamConsumerCreatedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -183,12 +191,12 @@ func waitStreamConsumerCreated(conn *kinesis.Kinesis, arn string) (*kinesis.Cons
 	return nil, err
 }
 
-func waitStreamConsumerDeleted(conn *kinesis.Kinesis, arn string) (*kinesis.ConsumerDescription, error) {
+func waitStreamConsumerDeleted(conn *kinesis.Kinesis, arn string, timeout time.Duration) (*kinesis.ConsumerDescription, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{kinesis.ConsumerStatusDeleting},
 		Target:  []string{},
 		Refresh: statusStreamConsumer(conn, arn),
-		Timeout: streamConsumerDeletedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -233,6 +233,43 @@ func TestAccKinesisStream_shardCount(t *testing.T) {
 	})
 }
 
+func TestAccKinesisStream_shardCountSplitMerge(t *testing.T) {
+	var stream kinesis.StreamDescriptionSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kinesis_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kinesis.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKinesisStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisStreamConfigShardCountUpdateStrategy(rName, 2, tfkinesis.ShardCountUpdateStrategySplitMerge),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "shard_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "shard_count_update_strategy", tfkinesis.ShardCountUpdateStrategySplitMerge),
+				),
+			},
+			{
+				Config: testAccKinesisStreamConfigShardCountUpdateStrategy(rName, 5, tfkinesis.ShardCountUpdateStrategySplitMerge),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "shard_count", "5"),
+				),
+			},
+			{
+				Config: testAccKinesisStreamConfigShardCountUpdateStrategy(rName, 3, tfkinesis.ShardCountUpdateStrategySplitMerge),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "shard_count", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKinesisStream_retentionPeriod(t *testing.T) {
 	var stream kinesis.StreamDescriptionSummary
 	resourceName := "aws_kinesis_stream.test"
@@ -719,6 +756,16 @@ resource "aws_kinesis_stream" "test" {
 `, rName, shardCount)
 }
 
+func testAccKinesisStreamConfigShardCountUpdateStrategy(rName string, shardCount int, strategy string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_stream" "test" {
+  name                        = %[1]q
+  shard_count                 = %[2]d
+  shard_count_update_strategy = %[3]q
+}
+`, rName, shardCount, strategy)
+}
+
 func testAccKinesisStreamConfigUpdateRetentionPeriod(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kinesis_stream" "test" {
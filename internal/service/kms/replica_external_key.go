@@ -137,7 +137,7 @@ func resourceReplicaExternalKeyCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	// Replication is initiated in the primary key's region.
-	session, err := conns.NewSessionForRegion(&conn.Config, primaryKeyARN.Region, meta.(*conns.AWSClient).TerraformVersion)
+	session, err := meta.(*conns.AWSClient).NewSessionForRegion(&conn.Config, primaryKeyARN.Region)
 
 	if err != nil {
 		return fmt.Errorf("error creating AWS session: %w", err)
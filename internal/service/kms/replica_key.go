@@ -125,7 +125,7 @@ func resourceReplicaKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Replication is initiated in the primary key's region.
-	session, err := conns.NewSessionForRegion(&conn.Config, primaryKeyARN.Region, meta.(*conns.AWSClient).TerraformVersion)
+	session, err := meta.(*conns.AWSClient).NewSessionForRegion(&conn.Config, primaryKeyARN.Region)
 
 	if err != nil {
 		return fmt.Errorf("error creating AWS session: %w", err)
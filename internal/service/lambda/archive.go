@@ -0,0 +1,148 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// sourceDirModTime is the modification time recorded for every archive entry
+// so that the archive (and therefore its hash) only depends on file contents.
+var sourceDirModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// zipSourceDir returns a deterministic ZIP archive of the regular files below dir.
+// Entries are added in lexical order with a fixed modification time and normalized
+// permissions. Exclude patterns use path.Match syntax; a pattern without a slash
+// is matched against the base name of every file and directory, otherwise it is
+// matched against the slash-separated path relative to dir. Excluded directories
+// are skipped entirely.
+func zipSourceDir(dir string, excludes []string) ([]byte, error) {
+	root, err := homedir.Expand(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range excludes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern (%s): %w", pattern, err)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p == root {
+			if !d.IsDir() {
+				return fmt.Errorf("%s is not a directory", root)
+			}
+
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if sourceDirExcluded(rel, excludes) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		info, err := os.Stat(p)
+
+		if err != nil {
+			return err
+		}
+
+		// Directory entries are implied by the file paths and symlinked
+		// directories are not followed.
+		if info.IsDir() || !info.Mode().IsRegular() {
+			return nil
+		}
+
+		header := &zip.FileHeader{
+			Name:     rel,
+			Method:   zip.Deflate,
+			Modified: sourceDirModTime,
+		}
+
+		if info.Mode()&0111 != 0 {
+			header.SetMode(0755)
+		} else {
+			header.SetMode(0644)
+		}
+
+		content, err := os.ReadFile(p)
+
+		if err != nil {
+			return err
+		}
+
+		fw, err := w.CreateHeader(header)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = fw.Write(content)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error archiving %s: %w", dir, err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("error archiving %s: %w", dir, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func sourceDirExcluded(rel string, excludes []string) bool {
+	for _, pattern := range excludes {
+		name := rel
+
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(rel)
+		}
+
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sourceCodeHash returns the base64-encoded SHA256 hash of a deployment package,
+// in the same format as the CodeSha256 reported by Lambda.
+func sourceCodeHash(b []byte) string {
+	h := sha256.Sum256(b)
+
+	return base64.StdEncoding.EncodeToString(h[:])
+}
@@ -0,0 +1,129 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func writeSourceDirFixture(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestZipSourceDir(t *testing.T) {
+	files := map[string]string{
+		"index.js":             "exports.handler = async () => {};",
+		"lib/util.js":          "module.exports = {};",
+		"node_modules/a/a.js":  "a",
+		"test/index.test.js":   "test",
+		"README.md":            "readme",
+		"lib/nested/.DS_Store": "junk",
+	}
+
+	dir1 := t.TempDir()
+	writeSourceDirFixture(t, dir1, files)
+
+	b1, err := zipSourceDir(dir1, []string{"*.md", ".DS_Store", "test"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(b1), int64(len(b1)))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var names []string
+
+	for _, f := range r.File {
+		names = append(names, f.Name)
+
+		if !f.Modified.Equal(sourceDirModTime) {
+			t.Errorf("%s: expected modification time %s, got %s", f.Name, sourceDirModTime, f.Modified)
+		}
+
+		if f.Mode() != 0644 {
+			t.Errorf("%s: expected mode 0644, got %s", f.Name, f.Mode())
+		}
+	}
+
+	expected := []string{"index.js", "lib/util.js", "node_modules/a/a.js"}
+
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected entries %v, got %v", expected, names)
+	}
+
+	// Same contents with different file times must produce an identical archive.
+	dir2 := t.TempDir()
+	writeSourceDirFixture(t, dir2, files)
+
+	later := time.Now().Add(time.Hour)
+
+	if err := os.Chtimes(filepath.Join(dir2, "index.js"), later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	b2, err := zipSourceDir(dir2, []string{"*.md", ".DS_Store", "test"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Equal(b1, b2) {
+		t.Error("expected identical archives for identical contents")
+	}
+
+	b3, err := zipSourceDir(dir2, []string{"*.md", ".DS_Store", "test", "node_modules/*"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if sourceCodeHash(b1) == sourceCodeHash(b3) {
+		t.Error("expected different hashes when excludes change")
+	}
+}
+
+func TestZipSourceDir_errors(t *testing.T) {
+	dir := t.TempDir()
+	writeSourceDirFixture(t, dir, map[string]string{"index.js": ""})
+
+	if _, err := zipSourceDir(filepath.Join(dir, "missing"), nil); err == nil {
+		t.Error("expected error for missing directory")
+	}
+
+	if _, err := zipSourceDir(filepath.Join(dir, "index.js"), nil); err == nil {
+		t.Error("expected error for file")
+	}
+
+	if _, err := zipSourceDir(dir, []string{"["}); err == nil {
+		t.Error("expected error for invalid exclude pattern")
+	}
+}
+
+func TestSourceCodeHash(t *testing.T) {
+	// echo -n "hello" | openssl dgst -sha256 -binary | base64
+	expected := "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ="
+
+	if got := sourceCodeHash([]byte("hello")); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
@@ -43,3 +43,79 @@ func FindEventSourceMappingConfiguration(conn *lambda.Lambda, input *lambda.GetE
 
 	return output, nil
 }
+
+// FindProvisionedConcurrencyConfigByFunctionNameAndQualifier returns the provisioned concurrency configuration
+// of the specified function alias or version.
+// Returns NotFoundError if no provisioned concurrency configuration is found.
+func FindProvisionedConcurrencyConfigByFunctionNameAndQualifier(conn *lambda.Lambda, functionName, qualifier string) (*lambda.GetProvisionedConcurrencyConfigOutput, error) {
+	input := &lambda.GetProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String(functionName),
+		Qualifier:    aws.String(qualifier),
+	}
+
+	output, err := conn.GetProvisionedConcurrencyConfig(input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeProvisionedConcurrencyConfigNotFoundException) || tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// FindLatestLayerVersion returns the highest layer version matching the specified input.
+// Returns NotFoundError if no layer version is found.
+func FindLatestLayerVersion(conn *lambda.Lambda, input *lambda.ListLayerVersionsInput) (*lambda.LayerVersionsListItem, error) {
+	var output *lambda.LayerVersionsListItem
+
+	err := conn.ListLayerVersionsPages(input, func(page *lambda.ListLayerVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LayerVersions {
+			if v == nil {
+				continue
+			}
+
+			if output == nil || aws.Int64Value(v.Version) > aws.Int64Value(output.Version) {
+				output = v
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -1880,6 +1880,82 @@ func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf lambda.GetFunctionOutput
+
+	sourceDir := t.TempDir()
+
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_source_dir_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_source_dir_%s", rString)
+	resourceName := "aws_lambda_function.test"
+
+	var timeBeforeUpdate time.Time
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccCopyFiles(map[string]string{
+						filepath.Join(sourceDir, "lambda.js"): "test-fixtures/lambda_func.js",
+						filepath.Join(sourceDir, "README.md"): "test-fixtures/lambda_func.js",
+					}); err != nil {
+						t.Fatalf("error copying files: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckFunctionName(&conf, funcName),
+					testAccCheckFunctionSourceCodeHashAttr(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "source_dir", sourceDir),
+					resource.TestCheckResourceAttr(resourceName, "source_dir_excludes.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_dir", "source_dir_excludes"},
+			},
+			{
+				// Changes to excluded files must not produce a diff.
+				PreConfig: func() {
+					if err := testAccCopyFiles(map[string]string{filepath.Join(sourceDir, "README.md"): "test-fixtures/lambda_func_modified.js"}); err != nil {
+						t.Fatalf("error copying files: %s", err)
+					}
+				},
+				Config:   testAccFunctionConfig_sourceDir(sourceDir, roleName, funcName),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					if err := testAccCopyFiles(map[string]string{filepath.Join(sourceDir, "lambda.js"): "test-fixtures/lambda_func_modified.js"}); err != nil {
+						t.Fatalf("error copying files: %s", err)
+					}
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckFunctionSourceCodeHashAttr(resourceName, &conf),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateAfter(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_S3Update_basic(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
@@ -2327,6 +2403,28 @@ func testAccCreateZipFromFiles(files map[string]string, zipFile *os.File) error
 	return w.Flush()
 }
 
+func testAccCheckFunctionSourceCodeHashAttr(n string, function *lambda.GetFunctionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return resource.TestCheckResourceAttr(n, "source_code_hash", aws.StringValue(function.Configuration.CodeSha256))(s)
+	}
+}
+
+// testAccCopyFiles copies files, keyed by destination path, from their source paths.
+func testAccCopyFiles(files map[string]string) error {
+	for dst, src := range files {
+		b, err := os.ReadFile(src)
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(dst, b, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func createTempFile(prefix string) (string, *os.File, error) {
 	f, err := os.CreateTemp(os.TempDir(), prefix)
 	if err != nil {
@@ -3374,6 +3472,39 @@ resource "aws_lambda_function" "test" {
 `, roleName, filePath, filePath, funcName)
 }
 
+func testAccFunctionConfig_sourceDir(sourceDir, roleName, funcName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "iam_for_lambda" {
+  name = "%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  source_dir          = "%s"
+  source_dir_excludes = ["*.md"]
+  function_name       = "%s"
+  role                = aws_iam_role.iam_for_lambda.arn
+  handler             = "exports.example"
+  runtime             = "nodejs12.x"
+}
+`, roleName, sourceDir, funcName)
+}
+
 func testAccFunctionConfig_local_name_only(filePath, roleName, funcName string) string {
 	return testAccFunctionConfig_local_name_only_tpl(filePath, roleName, funcName)
 }
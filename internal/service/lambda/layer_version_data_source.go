@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func DataSourceLayerVersion() *schema.Resource {
@@ -25,7 +26,7 @@ func DataSourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
-				ConflictsWith: []string{"compatible_runtimes"},
+				ConflictsWith: []string{"compatible_architecture", "compatible_runtime"},
 			},
 			"compatible_runtime": {
 				Type:          schema.TypeString,
@@ -114,16 +115,17 @@ func dataSourceLayerVersionRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[DEBUG] Looking up latest version for lambda layer %s", layerName)
-		listOutput, err := conn.ListLayerVersions(listInput)
-		if err != nil {
-			return fmt.Errorf("error listing Lambda Layer Versions (%s): %w", layerName, err)
+		layerVersion, err := FindLatestLayerVersion(conn, listInput)
+
+		if tfresource.NotFound(err) {
+			return fmt.Errorf("no Lambda Layer Version (%s) matches the compatible_runtime and compatible_architecture filters", layerName)
 		}
 
-		if len(listOutput.LayerVersions) == 0 {
-			return fmt.Errorf("error listing Lambda Layer Versions (%s): empty response", layerName)
+		if err != nil {
+			return fmt.Errorf("error listing Lambda Layer Versions (%s): %w", layerName, err)
 		}
 
-		version = aws.Int64Value(listOutput.LayerVersions[0].Version)
+		version = aws.Int64Value(layerVersion.Version)
 	}
 
 	input := &lambda.GetLayerVersionInput{
@@ -125,6 +125,29 @@ func TestAccLambdaLayerVersionDataSource_architectures(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersionDataSource_runtimeAndArchitecture(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_version.test"
+	resourceName := "aws_lambda_layer_version.x86"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionRuntimeAndArchitectureDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", resourceName, "version"),
+					resource.TestCheckResourceAttr(dataSourceName, "compatible_architectures.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "compatible_architectures.*", "x86_64"),
+				),
+			},
+		},
+	})
+}
+
 func testAccLayerVersionBasicDataSourceConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lambda_layer_version" "test" {
@@ -181,6 +204,30 @@ data "aws_lambda_layer_version" "test" {
 `, rName)
 }
 
+func testAccLayerVersionRuntimeAndArchitectureDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "x86" {
+  filename                 = "test-fixtures/lambdatest.zip"
+  layer_name               = %[1]q
+  compatible_runtimes      = ["nodejs12.x"]
+  compatible_architectures = ["x86_64"]
+}
+
+resource "aws_lambda_layer_version" "arm" {
+  filename                 = "test-fixtures/lambdatest.zip"
+  layer_name               = aws_lambda_layer_version.x86.layer_name
+  compatible_runtimes      = ["nodejs12.x"]
+  compatible_architectures = ["arm64"]
+}
+
+data "aws_lambda_layer_version" "test" {
+  layer_name              = aws_lambda_layer_version.arm.layer_name
+  compatible_runtime      = "nodejs12.x"
+  compatible_architecture = "x86_64"
+}
+`, rName)
+}
+
 func testAccLayerVersionArchitecturesX86DataSourceConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lambda_layer_version" "test" {
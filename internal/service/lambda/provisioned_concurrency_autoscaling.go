@@ -0,0 +1,272 @@
+package lambda
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	provisionedConcurrencyAutoscalingPolicyNameSuffix = "-ProvisionedConcurrencyUtilization"
+
+	provisionedConcurrencyAutoscalingPropagationTimeout = 2 * time.Minute
+)
+
+// ResourceProvisionedConcurrencyAutoscaling registers a function alias or version's provisioned concurrency
+// as an Application Auto Scaling target together with a target tracking policy on LambdaProvisionedConcurrencyUtilization.
+func ResourceProvisionedConcurrencyAutoscaling() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProvisionedConcurrencyAutoscalingPut,
+		Read:   resourceProvisionedConcurrencyAutoscalingRead,
+		Update: resourceProvisionedConcurrencyAutoscalingPut,
+		Delete: resourceProvisionedConcurrencyAutoscalingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disable_scale_in": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"max_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"policy_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"qualifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scale_in_cooldown": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"scale_out_cooldown": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"target_utilization": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0.1, 0.9),
+			},
+		},
+	}
+}
+
+func resourceProvisionedConcurrencyAutoscalingPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn
+
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id := fmt.Sprintf("%s:%s", functionName, qualifier)
+	resourceID := ProvisionedConcurrencyAutoscalingResourceID(functionName, qualifier)
+
+	targetInput := &applicationautoscaling.RegisterScalableTargetInput{
+		MaxCapacity:       aws.Int64(int64(d.Get("max_capacity").(int))),
+		MinCapacity:       aws.Int64(int64(d.Get("min_capacity").(int))),
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceLambda),
+	}
+
+	log.Printf("[DEBUG] Registering Lambda Provisioned Concurrency Autoscaling target: %s", targetInput)
+	// The Application Auto Scaling service-linked role may still be propagating.
+	_, err := tfresource.RetryWhen(provisionedConcurrencyAutoscalingPropagationTimeout,
+		func() (interface{}, error) {
+			return conn.RegisterScalableTarget(targetInput)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, applicationautoscaling.ErrCodeValidationException, "Unable to assume IAM role") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error registering Lambda Provisioned Concurrency Autoscaling (%s) target: %w", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	policyInput := &applicationautoscaling.PutScalingPolicyInput{
+		PolicyName:        aws.String(provisionedConcurrencyAutoscalingPolicyName(functionName, qualifier)),
+		PolicyType:        aws.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceLambda),
+		TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+			DisableScaleIn: aws.Bool(d.Get("disable_scale_in").(bool)),
+			PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+				PredefinedMetricType: aws.String(applicationautoscaling.MetricTypeLambdaProvisionedConcurrencyUtilization),
+			},
+			TargetValue: aws.Float64(d.Get("target_utilization").(float64)),
+		},
+	}
+
+	if v, ok := d.GetOk("scale_in_cooldown"); ok {
+		policyInput.TargetTrackingScalingPolicyConfiguration.ScaleInCooldown = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("scale_out_cooldown"); ok {
+		policyInput.TargetTrackingScalingPolicyConfiguration.ScaleOutCooldown = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Putting Lambda Provisioned Concurrency Autoscaling policy: %s", policyInput)
+	if _, err := conn.PutScalingPolicy(policyInput); err != nil {
+		return fmt.Errorf("error putting Lambda Provisioned Concurrency Autoscaling (%s) scaling policy: %w", id, err)
+	}
+
+	return resourceProvisionedConcurrencyAutoscalingRead(d, meta)
+}
+
+func resourceProvisionedConcurrencyAutoscalingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn
+
+	functionName, qualifier, err := ProvisionedConcurrencyConfigParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	resourceID := ProvisionedConcurrencyAutoscalingResourceID(functionName, qualifier)
+
+	target, err := tfappautoscaling.GetTarget(resourceID, applicationautoscaling.ServiceNamespaceLambda, applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency, conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Provisioned Concurrency Autoscaling (%s) target: %w", d.Id(), err)
+	}
+
+	if target == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Lambda Provisioned Concurrency Autoscaling (%s) target: not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Lambda Provisioned Concurrency Autoscaling (%s) target not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	policyName := provisionedConcurrencyAutoscalingPolicyName(functionName, qualifier)
+	policy, err := tfappautoscaling.FindScalingPolicy(conn, policyName, applicationautoscaling.ServiceNamespaceLambda, resourceID, applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Provisioned Concurrency Autoscaling (%s) scaling policy not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Provisioned Concurrency Autoscaling (%s) scaling policy: %w", d.Id(), err)
+	}
+
+	d.Set("function_name", functionName)
+	d.Set("max_capacity", target.MaxCapacity)
+	d.Set("min_capacity", target.MinCapacity)
+	d.Set("policy_arn", policy.PolicyARN)
+	d.Set("policy_name", policy.PolicyName)
+	d.Set("qualifier", qualifier)
+	d.Set("resource_id", target.ResourceId)
+
+	if v := policy.TargetTrackingScalingPolicyConfiguration; v != nil {
+		d.Set("disable_scale_in", v.DisableScaleIn)
+		d.Set("scale_in_cooldown", v.ScaleInCooldown)
+		d.Set("scale_out_cooldown", v.ScaleOutCooldown)
+		d.Set("target_utilization", v.TargetValue)
+	}
+
+	return nil
+}
+
+func resourceProvisionedConcurrencyAutoscalingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn
+
+	functionName, qualifier, err := ProvisionedConcurrencyConfigParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	resourceID := ProvisionedConcurrencyAutoscalingResourceID(functionName, qualifier)
+
+	log.Printf("[INFO] Deleting Lambda Provisioned Concurrency Autoscaling scaling policy: %s", d.Id())
+	_, err = conn.DeleteScalingPolicy(&applicationautoscaling.DeleteScalingPolicyInput{
+		PolicyName:        aws.String(provisionedConcurrencyAutoscalingPolicyName(functionName, qualifier)),
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceLambda),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, applicationautoscaling.ErrCodeObjectNotFoundException) {
+		return fmt.Errorf("error deleting Lambda Provisioned Concurrency Autoscaling (%s) scaling policy: %w", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deregistering Lambda Provisioned Concurrency Autoscaling target: %s", d.Id())
+	_, err = conn.DeregisterScalableTarget(&applicationautoscaling.DeregisterScalableTargetInput{
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency),
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceLambda),
+	})
+
+	if tfawserr.ErrCodeEquals(err, applicationautoscaling.ErrCodeObjectNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering Lambda Provisioned Concurrency Autoscaling (%s) target: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// ProvisionedConcurrencyAutoscalingResourceID returns the Application Auto Scaling resource ID of a function alias or version.
+func ProvisionedConcurrencyAutoscalingResourceID(functionName, qualifier string) string {
+	return fmt.Sprintf("function:%s:%s", functionName, qualifier)
+}
+
+func provisionedConcurrencyAutoscalingPolicyName(functionName, qualifier string) string {
+	return fmt.Sprintf("%s-%s%s", functionName, qualifier, provisionedConcurrencyAutoscalingPolicyNameSuffix)
+}
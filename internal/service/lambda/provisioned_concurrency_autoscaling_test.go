@@ -0,0 +1,138 @@
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+)
+
+func TestAccLambdaProvisionedConcurrencyAutoscaling_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_provisioned_concurrency_autoscaling.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckProvisionedConcurrencyAutoscalingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedConcurrencyAutoscalingConfig(rName, 1, 2, 0.7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedConcurrencyAutoscalingExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", "aws_lambda_alias.test", "function_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", "aws_lambda_alias.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "resource_id", fmt.Sprintf("function:%s:test", rName)),
+					resource.TestCheckResourceAttr(resourceName, "min_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_utilization", "0.7"),
+					resource.TestCheckResourceAttr(resourceName, "disable_scale_in", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_arn"),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", fmt.Sprintf("%s-test-ProvisionedConcurrencyUtilization", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProvisionedConcurrencyAutoscalingConfig(rName, 2, 5, 0.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedConcurrencyAutoscalingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "min_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "5"),
+					resource.TestCheckResourceAttr(resourceName, "target_utilization", "0.5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProvisionedConcurrencyAutoscalingDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lambda_provisioned_concurrency_autoscaling" {
+			continue
+		}
+
+		functionName, qualifier, err := tflambda.ProvisionedConcurrencyConfigParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		target, err := tfappautoscaling.GetTarget(tflambda.ProvisionedConcurrencyAutoscalingResourceID(functionName, qualifier), applicationautoscaling.ServiceNamespaceLambda, applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if target != nil {
+			return fmt.Errorf("Lambda Provisioned Concurrency Autoscaling %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckProvisionedConcurrencyAutoscalingExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lambda Provisioned Concurrency Autoscaling ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn
+
+		functionName, qualifier, err := tflambda.ProvisionedConcurrencyConfigParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		target, err := tfappautoscaling.GetTarget(tflambda.ProvisionedConcurrencyAutoscalingResourceID(functionName, qualifier), applicationautoscaling.ServiceNamespaceLambda, applicationautoscaling.ScalableDimensionLambdaFunctionProvisionedConcurrency, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if target == nil {
+			return fmt.Errorf("Lambda Provisioned Concurrency Autoscaling %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccProvisionedConcurrencyAutoscalingConfig(rName string, minCapacity, maxCapacity int, targetUtilization float64) string {
+	return testAccProvisionedConcurrencyBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_lambda_alias" "test" {
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+  name             = "test"
+}
+
+resource "aws_lambda_provisioned_concurrency_autoscaling" "test" {
+  function_name      = aws_lambda_alias.test.function_name
+  qualifier          = aws_lambda_alias.test.name
+  min_capacity       = %[1]d
+  max_capacity       = %[2]d
+  target_utilization = %[3]g
+}
+`, minCapacity, maxCapacity, targetUtilization)
+}
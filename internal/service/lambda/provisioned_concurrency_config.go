@@ -9,10 +9,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceProvisionedConcurrencyConfig() *schema.Resource {
@@ -36,6 +36,11 @@ func ResourceProvisionedConcurrencyConfig() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"poll_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validProvisionedConcurrencyConfigPollInterval,
+			},
 			"provisioned_concurrent_executions": {
 				Type:         schema.TypeInt,
 				Required:     true,
@@ -47,6 +52,14 @@ func ResourceProvisionedConcurrencyConfig() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -70,7 +83,7 @@ func resourceProvisionedConcurrencyConfigCreate(d *schema.ResourceData, meta int
 
 	d.SetId(fmt.Sprintf("%s:%s", functionName, qualifier))
 
-	if err := waitForProvisionedConcurrencyConfigStatusReady(conn, functionName, qualifier, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitProvisionedConcurrencyConfigReady(conn, functionName, qualifier, d.Timeout(schema.TimeoutCreate), provisionedConcurrencyConfigPollInterval(d)); err != nil {
 		return fmt.Errorf("error waiting for Lambda Provisioned Concurrency Config (%s) to be ready: %s", d.Id(), err)
 	}
 
@@ -86,14 +99,9 @@ func resourceProvisionedConcurrencyConfigRead(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	input := &lambda.GetProvisionedConcurrencyConfigInput{
-		FunctionName: aws.String(functionName),
-		Qualifier:    aws.String(qualifier),
-	}
+	output, err := FindProvisionedConcurrencyConfigByFunctionNameAndQualifier(conn, functionName, qualifier)
 
-	output, err := conn.GetProvisionedConcurrencyConfig(input)
-
-	if tfawserr.ErrMessageContains(err, lambda.ErrCodeProvisionedConcurrencyConfigNotFoundException, "") || tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceNotFoundException, "") {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Lambda Provisioned Concurrency Config (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -106,6 +114,8 @@ func resourceProvisionedConcurrencyConfigRead(d *schema.ResourceData, meta inter
 	d.Set("function_name", functionName)
 	d.Set("provisioned_concurrent_executions", output.AllocatedProvisionedConcurrentExecutions)
 	d.Set("qualifier", qualifier)
+	d.Set("status", output.Status)
+	d.Set("status_reason", output.StatusReason)
 
 	return nil
 }
@@ -131,7 +141,7 @@ func resourceProvisionedConcurrencyConfigUpdate(d *schema.ResourceData, meta int
 		return fmt.Errorf("error putting Lambda Provisioned Concurrency Config (%s:%s): %s", functionName, qualifier, err)
 	}
 
-	if err := waitForProvisionedConcurrencyConfigStatusReady(conn, functionName, qualifier, d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if _, err := waitProvisionedConcurrencyConfigReady(conn, functionName, qualifier, d.Timeout(schema.TimeoutUpdate), provisionedConcurrencyConfigPollInterval(d)); err != nil {
 		return fmt.Errorf("error waiting for Lambda Provisioned Concurrency Config (%s) to be ready: %s", d.Id(), err)
 	}
 
@@ -175,39 +185,14 @@ func ProvisionedConcurrencyConfigParseID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
-func refreshProvisionedConcurrencyConfigStatus(conn *lambda.Lambda, functionName, qualifier string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		input := &lambda.GetProvisionedConcurrencyConfigInput{
-			FunctionName: aws.String(functionName),
-			Qualifier:    aws.String(qualifier),
-		}
+// provisionedConcurrencyConfigPollInterval returns the configured interval between status checks,
+// or zero to use the default backoff.
+func provisionedConcurrencyConfigPollInterval(d *schema.ResourceData) time.Duration {
+	pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
 
-		output, err := conn.GetProvisionedConcurrencyConfig(input)
-
-		if err != nil {
-			return "", "", err
-		}
-
-		status := aws.StringValue(output.Status)
-
-		if status == lambda.ProvisionedConcurrencyStatusEnumFailed {
-			return output, status, fmt.Errorf("status reason: %s", aws.StringValue(output.StatusReason))
-		}
-
-		return output, status, nil
-	}
-}
-
-func waitForProvisionedConcurrencyConfigStatusReady(conn *lambda.Lambda, functionName, qualifier string, timeout time.Duration) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{lambda.ProvisionedConcurrencyStatusEnumInProgress},
-		Target:  []string{lambda.ProvisionedConcurrencyStatusEnumReady},
-		Refresh: refreshProvisionedConcurrencyConfigStatus(conn, functionName, qualifier),
-		Timeout: timeout,
-		Delay:   5 * time.Second,
+	if err != nil {
+		return 0
 	}
 
-	_, err := stateConf.WaitForState()
-
-	return err
+	return pollInterval
 }
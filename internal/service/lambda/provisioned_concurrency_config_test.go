@@ -33,6 +33,7 @@ func TestAccLambdaProvisionedConcurrencyConfig_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "function_name", lambdaFunctionResourceName, "function_name"),
 					resource.TestCheckResourceAttr(resourceName, "provisioned_concurrent_executions", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "qualifier", lambdaFunctionResourceName, "version"),
+					resource.TestCheckResourceAttr(resourceName, "status", lambda.ProvisionedConcurrencyStatusEnumReady),
 				),
 			},
 			{
@@ -44,6 +45,34 @@ func TestAccLambdaProvisionedConcurrencyConfig_basic(t *testing.T) {
 	})
 }
 
+func TestAccLambdaProvisionedConcurrencyConfig_pollInterval(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_provisioned_concurrency_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckProvisionedConcurrencyConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedConcurrencyPollIntervalConfig(rName, "10s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedConcurrencyExistsConfig(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "poll_interval", "10s"),
+					resource.TestCheckResourceAttr(resourceName, "status", lambda.ProvisionedConcurrencyStatusEnumReady),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"poll_interval"},
+			},
+		},
+	})
+}
+
 func TestAccLambdaProvisionedConcurrencyConfig_Disappears_lambdaFunction(t *testing.T) {
 	var function lambda.GetFunctionOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -317,6 +346,17 @@ resource "aws_lambda_provisioned_concurrency_config" "test" {
 `, provisionedConcurrentExecutions)
 }
 
+func testAccProvisionedConcurrencyPollIntervalConfig(rName, pollInterval string) string {
+	return testAccProvisionedConcurrencyBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_lambda_provisioned_concurrency_config" "test" {
+  function_name                     = aws_lambda_function.test.function_name
+  poll_interval                     = %[1]q
+  provisioned_concurrent_executions = 1
+  qualifier                         = aws_lambda_function.test.version
+}
+`, pollInterval)
+}
+
 func testAccProvisionedConcurrencyQualifierAliasNameConfig(rName string) string {
 	return testAccProvisionedConcurrencyBaseConfig(rName) + `
 resource "aws_lambda_alias" "test" {
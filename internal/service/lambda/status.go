@@ -32,3 +32,19 @@ func statusEventSourceMappingState(conn *lambda.Lambda, id string) resource.Stat
 		return eventSourceMappingConfiguration, aws.StringValue(eventSourceMappingConfiguration.State), nil
 	}
 }
+
+func statusProvisionedConcurrencyConfig(conn *lambda.Lambda, functionName, qualifier string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProvisionedConcurrencyConfigByFunctionNameAndQualifier(conn, functionName, qualifier)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -3,6 +3,7 @@ package lambda
 import (
 	"fmt"
 	"regexp"
+	"time"
 )
 
 func validFunctionName(v interface{}, k string) (ws []string, errors []error) {
@@ -71,6 +72,22 @@ func validQualifier(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+func validProvisionedConcurrencyConfigPollInterval(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be parsed as a duration: %s", k, err))
+		return
+	}
+	if duration < 5*time.Second || duration > 60*time.Second {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 5 and 60 seconds: %q", k, value))
+	}
+
+	return
+}
+
 func validPolicyStatementID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -122,6 +122,34 @@ func TestValidQualifier(t *testing.T) {
 	}
 }
 
+func TestValidProvisionedConcurrencyConfigPollInterval(t *testing.T) {
+	validIntervals := []string{
+		"5s",
+		"30s",
+		"1m",
+	}
+	for _, v := range validIntervals {
+		_, errors := validProvisionedConcurrencyConfigPollInterval(v, "poll_interval")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid poll interval: %q", v, errors)
+		}
+	}
+
+	invalidIntervals := []string{
+		"",
+		"30",
+		"1s",
+		"61s",
+		"-10s",
+	}
+	for _, v := range invalidIntervals {
+		_, errors := validProvisionedConcurrencyConfigPollInterval(v, "poll_interval")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid poll interval", v)
+		}
+	}
+}
+
 func TestValidPolicyStatementID(t *testing.T) {
 	validNames := []string{
 		"YadaHereAndThere",
@@ -1,10 +1,13 @@
 package lambda
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -84,3 +87,28 @@ func waitEventSourceMappingUpdate(conn *lambda.Lambda, id string) (*lambda.Event
 
 	return nil, err
 }
+
+// waitProvisionedConcurrencyConfigReady waits for a provisioned concurrency configuration to be allocated.
+// A non-zero pollInterval replaces the default exponential backoff with a fixed interval between status checks.
+func waitProvisionedConcurrencyConfigReady(conn *lambda.Lambda, functionName, qualifier string, timeout, pollInterval time.Duration) (*lambda.GetProvisionedConcurrencyConfigOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{lambda.ProvisionedConcurrencyStatusEnumInProgress},
+		Target:       []string{lambda.ProvisionedConcurrencyStatusEnumReady},
+		Refresh:      statusProvisionedConcurrencyConfig(conn, functionName, qualifier),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: pollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*lambda.GetProvisionedConcurrencyConfigOutput); ok {
+		if v := aws.StringValue(output.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
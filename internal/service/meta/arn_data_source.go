@@ -2,6 +2,7 @@ package meta
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,6 +39,19 @@ func DataSourceARN() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_components": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -56,5 +70,90 @@ func dataSourceARNRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("account", arn.AccountID)
 	d.Set("resource", arn.Resource)
 
+	resourceType, resourceID := arnResourceTypeAndID(arn)
+	d.Set("resource_id", resourceID)
+	d.Set("resource_type", resourceType)
+
+	if err := d.Set("service_components", arnServiceComponents(arn.Service, resourceType, resourceID)); err != nil {
+		return fmt.Errorf("error setting service_components: %w", err)
+	}
+
 	return nil
 }
+
+// arnResourceTypeAndID splits an ARN's resource into its type and ID.
+// The type is the text before the first slash or colon, if any.
+func arnResourceTypeAndID(v arn.ARN) (string, string) {
+	// S3 bucket and object ARNs have no region, account or resource type: arn:aws:s3:::bucket/key.
+	if v.Service == "s3" && v.Region == "" && v.AccountID == "" {
+		return "", v.Resource
+	}
+
+	if i := strings.IndexAny(v.Resource, "/:"); i != -1 {
+		return v.Resource[:i], v.Resource[i+1:]
+	}
+
+	return "", v.Resource
+}
+
+// arnServiceComponents returns the service-specific components of an ARN's resource.
+func arnServiceComponents(service, resourceType, resourceID string) map[string]string {
+	components := make(map[string]string)
+
+	switch service {
+	case "dynamodb":
+		if resourceType == "table" {
+			parts := strings.Split(resourceID, "/")
+			components["table_name"] = parts[0]
+
+			if len(parts) == 3 && parts[1] == "stream" {
+				components["stream_label"] = parts[2]
+			}
+		}
+	case "gamelift":
+		switch resourceType {
+		case "alias", "build", "fleet", "script":
+			components[resourceType+"_id"] = resourceID
+		case "gamesessionqueue":
+			components["game_session_queue_name"] = resourceID
+		}
+	case "iam":
+		if resourceType != "" {
+			i := strings.LastIndex(resourceID, "/")
+			components["name"] = resourceID[i+1:]
+			components["path"] = "/" + resourceID[:i+1]
+		}
+	case "lambda":
+		if resourceType == "function" {
+			parts := strings.SplitN(resourceID, ":", 2)
+			components["function_name"] = parts[0]
+
+			if len(parts) == 2 {
+				components["qualifier"] = parts[1]
+			}
+		}
+	case "logs":
+		if resourceType == "log-group" {
+			components["log_group_name"] = strings.TrimSuffix(resourceID, ":*")
+		}
+	case "s3":
+		if resourceType == "" {
+			parts := strings.SplitN(resourceID, "/", 2)
+			components["bucket"] = parts[0]
+
+			if len(parts) == 2 {
+				components["key"] = parts[1]
+			}
+		}
+	case "sns":
+		if resourceType == "" {
+			components["topic_name"] = resourceID
+		}
+	case "sqs":
+		if resourceType == "" {
+			components["queue_name"] = resourceID
+		}
+	}
+
+	return components
+}
@@ -36,7 +36,75 @@ func TestAccMetaARNDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "partition", testARN.Partition),
 					resource.TestCheckResourceAttr(resourceName, "region", testARN.Region),
 					resource.TestCheckResourceAttr(resourceName, "resource", testARN.Resource),
+					resource.TestCheckResourceAttr(resourceName, "resource_id", "mysql-db"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "db"),
 					resource.TestCheckResourceAttr(resourceName, "service", testARN.Service),
+					resource.TestCheckResourceAttr(resourceName, "service_components.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaARNDataSource_gameLift(t *testing.T) {
+	resourceName := "data.aws_arn.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNDataSourceConfig("arn:aws:gamelift:us-west-2:123456789012:fleet/fleet-2222bbbb-33cc-44dd-55ee-6666ffff77aa"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resource_id", "fleet-2222bbbb-33cc-44dd-55ee-6666ffff77aa"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "fleet"),
+					resource.TestCheckResourceAttr(resourceName, "service_components.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_components.fleet_id", "fleet-2222bbbb-33cc-44dd-55ee-6666ffff77aa"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaARNDataSource_iam(t *testing.T) {
+	resourceName := "data.aws_arn.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNDataSourceConfig("arn:aws:iam::123456789012:role/service-role/example"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resource_id", "service-role/example"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "role"),
+					resource.TestCheckResourceAttr(resourceName, "service_components.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "service_components.name", "example"),
+					resource.TestCheckResourceAttr(resourceName, "service_components.path", "/service-role/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaARNDataSource_s3(t *testing.T) {
+	resourceName := "data.aws_arn.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNDataSourceConfig("arn:aws:s3:::example-bucket/path/to/object.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resource_id", "example-bucket/path/to/object.txt"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "service_components.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "service_components.bucket", "example-bucket"),
+					resource.TestCheckResourceAttr(resourceName, "service_components.key", "path/to/object.txt"),
 				),
 			},
 		},
@@ -0,0 +1,114 @@
+package meta
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+func TestARNServiceComponents(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		ARN      string
+		Expected map[string]string
+	}{
+		{
+			Name:     "unsupported service",
+			ARN:      "arn:aws:ec2:us-east-1:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]string{},
+		},
+		{
+			Name:     "dynamodb table",
+			ARN:      "arn:aws:dynamodb:us-east-1:123456789012:table/example", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]string{"table_name": "example"},
+		},
+		{
+			Name:     "dynamodb stream",
+			ARN:      "arn:aws:dynamodb:us-east-1:123456789012:table/example/stream/2022-01-01T00:00:00.000", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]string{"table_name": "example", "stream_label": "2022-01-01T00:00:00.000"},
+		},
+		{
+			Name:     "gamelift fleet",
+			ARN:      "arn:aws:gamelift:us-east-1:123456789012:fleet/fleet-12345678", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]string{"fleet_id": "fleet-12345678"},
+		},
+		{
+			Name:     "gamelift game session queue",
+			ARN:      "arn:aws:gamelift:us-east-1:123456789012:gamesessionqueue/example", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]string{"game_session_queue_name": "example"},
+		},
+		{
+			Name:     "iam role without path",
+			ARN:      "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+			Expected: map[string]string{"name": "example", "path": "/"},
+		},
+		{
+			Name:     "iam role with path",
+			ARN:      "arn:aws:iam::123456789012:role/service/team/example", //lintignore:AWSAT005
+			Expected: map[string]string{"name": "example", "path": "/service/team/"},
+		},
+		{
+			Name:     "iam root",
+			ARN:      "arn:aws:iam::123456789012:root", //lintignore:AWSAT005
+			Expected: map[string]string{},
+		},
+		{
+			Name:     "lambda function",
+			ARN:      "arn:aws:lambda:us-east-1:123456789012:function:example", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]string{"function_name": "example"},
+		},
+		{
+			Name:     "lambda function with qualifier",
+			ARN:      "arn:aws:lambda:us-east-1:123456789012:function:example:live", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]string{"function_name": "example", "qualifier": "live"},
+		},
+		{
+			Name:     "log group",
+			ARN:      "arn:aws:logs:us-east-1:123456789012:log-group:/aws/lambda/example:*", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]string{"log_group_name": "/aws/lambda/example"},
+		},
+		{
+			Name:     "s3 bucket",
+			ARN:      "arn:aws:s3:::example", //lintignore:AWSAT005
+			Expected: map[string]string{"bucket": "example"},
+		},
+		{
+			Name:     "s3 object",
+			ARN:      "arn:aws:s3:::example/path/to/key", //lintignore:AWSAT005
+			Expected: map[string]string{"bucket": "example", "key": "path/to/key"},
+		},
+		{
+			Name:     "s3 access point",
+			ARN:      "arn:aws:s3:us-east-1:123456789012:accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]string{},
+		},
+		{
+			Name:     "sns topic",
+			ARN:      "arn:aws:sns:us-east-1:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]string{"topic_name": "example"},
+		},
+		{
+			Name:     "sqs queue",
+			ARN:      "arn:aws:sqs:us-east-1:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			Expected: map[string]string{"queue_name": "example"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			v, err := arn.Parse(testCase.ARN)
+
+			if err != nil {
+				t.Fatalf("error parsing ARN (%s): %s", testCase.ARN, err)
+			}
+
+			resourceType, resourceID := arnResourceTypeAndID(v)
+			got := arnServiceComponents(v.Service, resourceType, resourceID)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -13,7 +13,27 @@ func DataSourceDefaultTags() *schema.Resource {
 		Read: dataSourceDefaultTagsRead,
 
 		Schema: map[string]*schema.Schema{
-			"tags": tftags.TagsSchemaComputed(),
+			"ignore_tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_prefixes": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"resource_tags": tftags.TagsSchema(),
+			"tags":          tftags.TagsSchemaComputed(),
+			"tags_all":      tftags.TagsSchemaComputed(),
 		},
 	}
 }
@@ -34,5 +54,29 @@ func dataSourceDefaultTagsRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("tags", nil)
 	}
 
+	// Resource tags take precedence over default tags, as they do for a resource's tags_all.
+	tagsAll := defaultTagsConfig.MergeTags(tftags.New(d.Get("resource_tags").(map[string]interface{})))
+
+	if err := d.Set("tags_all", tagsAll.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	if err := d.Set("ignore_tags", flattenIgnoreTagsConfig(ignoreTagsConfig)); err != nil {
+		return fmt.Errorf("error setting ignore_tags: %w", err)
+	}
+
 	return nil
 }
+
+func flattenIgnoreTagsConfig(config *tftags.IgnoreConfig) []interface{} {
+	if config == nil || (len(config.Keys) == 0 && len(config.KeyPrefixes) == 0) {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"key_prefixes": config.KeyPrefixes.Keys(),
+		"keys":         config.Keys.Keys(),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -1,6 +1,7 @@
 package meta_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -112,6 +113,57 @@ func TestAccMetaDefaultTagsDataSource_ignore(t *testing.T) {
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "ignore_tags.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ignore_tags.0.keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "ignore_tags.0.keys.*", "Tabac"),
+					resource.TestCheckResourceAttr(dataSourceName, "ignore_tags.0.key_prefixes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaDefaultTagsDataSource_resourceTags(t *testing.T) {
+	var providers []*schema.Provider
+
+	dataSourceName := "data.aws_default_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("first", "default", "second", "default"),
+					testAccDefaultTagsDataSourceResourceTags("second", "resource", "third", "resource"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.first", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.second", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.first", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.second", "resource"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.third", "resource"),
+					resource.TestCheckResourceAttr(dataSourceName, "ignore_tags.#", "0"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeyPrefixes1("first", "default", "th"),
+					testAccDefaultTagsDataSourceResourceTags("second", "resource", "third", "resource"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.first", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.second", "resource"),
+					resource.TestCheckResourceAttr(dataSourceName, "ignore_tags.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ignore_tags.0.key_prefixes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "ignore_tags.0.key_prefixes.*", "th"),
 				),
 			},
 		},
@@ -121,3 +173,14 @@ func TestAccMetaDefaultTagsDataSource_ignore(t *testing.T) {
 func testAccDefaultTagsDataSource() string {
 	return `data "aws_default_tags" "test" {}`
 }
+
+func testAccDefaultTagsDataSourceResourceTags(tag1, value1, tag2, value2 string) string {
+	return fmt.Sprintf(`
+data "aws_default_tags" "test" {
+  resource_tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tag1, value1, tag2, value2)
+}
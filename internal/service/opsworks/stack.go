@@ -421,7 +421,7 @@ func opsworksConnForRegion(region string, meta interface{}) (*opsworks.OpsWorks,
 		return originalConn, nil
 	}
 
-	sess, err := conns.NewSessionForRegion(&originalConn.Config, region, meta.(*conns.AWSClient).TerraformVersion)
+	sess, err := meta.(*conns.AWSClient).NewSessionForRegion(&originalConn.Config, region)
 
 	if err != nil {
 		return nil, fmt.Errorf("error creating AWS session: %w", err)
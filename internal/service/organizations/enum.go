@@ -0,0 +1,14 @@
+package organizations
+
+import (
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+// These should be defined in the AWS SDK for Go.
+const (
+	PolicyTypeResourceControlPolicy = "RESOURCE_CONTROL_POLICY"
+)
+
+func PolicyType_Values() []string {
+	return append(organizations.PolicyType_Values(), PolicyTypeResourceControlPolicy)
+}
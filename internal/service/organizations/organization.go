@@ -154,7 +154,7 @@ func ResourceOrganization() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice(organizations.PolicyType_Values(), false),
+					ValidateFunc: validation.StringInSlice(PolicyType_Values(), false),
 				},
 			},
 			"feature_set": {
@@ -32,11 +32,13 @@ func TestAccOrganizations_serial(t *testing.T) {
 		"Policy": {
 			"basic":                  testAccPolicy_basic,
 			"concurrent":             testAccPolicy_concurrent,
+			"ContentSize":            testAccPolicy_contentSize,
 			"Description":            testAccPolicy_description,
 			"Tags":                   testAccPolicy_tags,
 			"disappears":             testAccPolicy_disappears,
 			"Type_AI_OPT_OUT":        testAccPolicy_type_AI_OPT_OUT,
 			"Type_Backup":            testAccPolicy_type_Backup,
+			"Type_RCP":               testAccPolicy_type_RCP,
 			"Type_SCP":               testAccPolicy_type_SCP,
 			"Type_Tag":               testAccPolicy_type_Tag,
 			"ImportAwsManagedPolicy": testAccPolicy_ImportAwsManagedPolicy,
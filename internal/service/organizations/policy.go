@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -53,16 +54,44 @@ func ResourcePolicy() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Default:      organizations.PolicyTypeServiceControlPolicy,
-				ValidateFunc: validation.StringInSlice(organizations.PolicyType_Values(), false),
+				ValidateFunc: validation.StringInSlice(PolicyType_Values(), false),
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourcePolicyCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+// policyContentMaxSize is the maximum size, in characters, of a policy document of each type.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html.
+var policyContentMaxSize = map[string]int{
+	organizations.PolicyTypeAiservicesOptOutPolicy: 2500,
+	organizations.PolicyTypeBackupPolicy:           10000,
+	organizations.PolicyTypeServiceControlPolicy:   5120,
+	organizations.PolicyTypeTagPolicy:              10000,
+	PolicyTypeResourceControlPolicy:                5120,
+}
+
+func resourcePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("content") {
+		return nil
+	}
+
+	policyType := diff.Get("type").(string)
+	content := diff.Get("content").(string)
+
+	if max, ok := policyContentMaxSize[policyType]; ok && len(content) > max {
+		return fmt.Errorf("content is %d characters long, which exceeds the maximum of %d characters for %s policies", len(content), max, policyType)
+	}
+
+	return nil
+}
+
 func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).OrganizationsConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -0,0 +1,5 @@
+package quicksight
+
+const (
+	DefaultNamespace = "default"
+)
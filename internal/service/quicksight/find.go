@@ -1,8 +1,13 @@
 package quicksight
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindGroupMembership(conn *quicksight.QuickSight, listInput *quicksight.ListGroupMembershipsInput, userName string) (bool, error) {
@@ -31,3 +36,123 @@ func FindGroupMembership(conn *quicksight.QuickSight, listInput *quicksight.List
 
 	return found, nil
 }
+
+func FindNamespaceByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, namespace string) (*quicksight.NamespaceInfoV2, error) {
+	input := &quicksight.DescribeNamespaceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+	}
+
+	output, err := conn.DescribeNamespaceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Namespace == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Namespace, nil
+}
+
+func FindFolderByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, folderID string) (*quicksight.Folder, error) {
+	input := &quicksight.DescribeFolderInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	output, err := conn.DescribeFolderWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Folder == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Folder, nil
+}
+
+func FindFolderPermissionsByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, folderID string) ([]*quicksight.ResourcePermission, error) {
+	input := &quicksight.DescribeFolderPermissionsInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	output, err := conn.DescribeFolderPermissionsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Permissions, nil
+}
+
+func FindFolderMembershipByID(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, folderID, memberID string) (*quicksight.MemberIdArnPair, error) {
+	input := &quicksight.ListFolderMembersInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	}
+
+	for {
+		output, err := conn.ListFolderMembersWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, member := range output.FolderMemberList {
+			if aws.StringValue(member.MemberId) == memberID {
+				return member, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
@@ -0,0 +1,334 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFolder() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFolderCreate,
+		ReadWithoutTimeout:   resourceFolderRead,
+		UpdateWithoutTimeout: resourceFolderUpdate,
+		DeleteWithoutTimeout: resourceFolderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"folder_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"folder_path": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"folder_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      quicksight.FolderTypeShared,
+				ValidateFunc: validation.StringInSlice(quicksight.FolderType_Values(), false),
+			},
+
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+
+			"parent_folder_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 64,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							MinItems: 1,
+							MaxItems: 16,
+						},
+						"principal": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFolderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	folderID := d.Get("folder_id").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	input := &quicksight.CreateFolderInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+		FolderType:   aws.String(d.Get("folder_type").(string)),
+		Name:         aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("parent_folder_arn"); ok {
+		input.ParentFolderArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandQuickSightDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Folder: %s", input)
+	_, err := conn.CreateFolderWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Folder (%s): %s", folderID, err)
+	}
+
+	d.SetId(FolderCreateID(awsAccountID, folderID))
+
+	return resourceFolderRead(ctx, d, meta)
+}
+
+func resourceFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountID, folderID, err := FolderParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	folder, err := FindFolderByID(ctx, conn, awsAccountID, folderID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Folder (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Folder (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", folder.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("created_time", aws.TimeValue(folder.CreatedTime).Format(time.RFC3339))
+	d.Set("folder_id", folder.FolderId)
+	d.Set("folder_type", folder.FolderType)
+	d.Set("last_updated_time", aws.TimeValue(folder.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", folder.Name)
+
+	// The last element of the folder path is the ARN of the direct parent folder.
+	if n := len(folder.FolderPath); n > 0 {
+		d.Set("parent_folder_arn", folder.FolderPath[n-1])
+	} else {
+		d.Set("parent_folder_arn", nil)
+	}
+
+	if err := d.Set("folder_path", aws.StringValueSlice(folder.FolderPath)); err != nil {
+		return diag.Errorf("error setting folder_path: %s", err)
+	}
+
+	permissions, err := FindFolderPermissionsByID(ctx, conn, awsAccountID, folderID)
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Folder (%s) permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenQuickSightPermissions(permissions)); err != nil {
+		return diag.Errorf("error setting permission: %s", err)
+	}
+
+	tags, err := ListTags(conn, aws.StringValue(folder.Arn))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Folder (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFolderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountID, folderID, err := FolderParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		input := &quicksight.UpdateFolderInput{
+			AwsAccountId: aws.String(awsAccountID),
+			FolderId:     aws.String(folderID),
+			Name:         aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Folder: %s", input)
+		if _, err := conn.UpdateFolderWithContext(ctx, input); err != nil {
+			return diag.Errorf("error updating QuickSight Folder (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		toGrant, toRevoke := DiffPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateFolderPermissionsInput{
+			AwsAccountId: aws.String(awsAccountID),
+			FolderId:     aws.String(folderID),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Folder permissions: %s", input)
+		if _, err := conn.UpdateFolderPermissionsWithContext(ctx, input); err != nil {
+			return diag.Errorf("error updating QuickSight Folder (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Folder (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFolderRead(ctx, d, meta)
+}
+
+func resourceFolderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountID, folderID, err := FolderParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting QuickSight Folder: %s", d.Id())
+	_, err = conn.DeleteFolderWithContext(ctx, &quicksight.DeleteFolderInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Folder (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const folderIDSeparator = "/"
+
+func FolderCreateID(awsAccountID, folderID string) string {
+	return strings.Join([]string{awsAccountID, folderID}, folderIDSeparator)
+}
+
+func FolderParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, folderIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID%sFOLDER_ID", id, folderIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,163 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFolderMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFolderMembershipCreate,
+		ReadWithoutTimeout:   resourceFolderMembershipRead,
+		DeleteWithoutTimeout: resourceFolderMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"folder_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.MemberType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceFolderMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	folderID := d.Get("folder_id").(string)
+	memberID := d.Get("member_id").(string)
+	memberType := d.Get("member_type").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	input := &quicksight.CreateFolderMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+		MemberId:     aws.String(memberID),
+		MemberType:   aws.String(memberType),
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Folder Membership: %s", input)
+	_, err := conn.CreateFolderMembershipWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error adding %s (%s) to QuickSight Folder (%s): %s", memberType, memberID, folderID, err)
+	}
+
+	d.SetId(FolderMembershipCreateID(awsAccountID, folderID, memberType, memberID))
+
+	return resourceFolderMembershipRead(ctx, d, meta)
+}
+
+func resourceFolderMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountID, folderID, memberType, memberID, err := FolderMembershipParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = FindFolderMembershipByID(ctx, conn, awsAccountID, folderID, memberID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Folder Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Folder Membership (%s): %s", d.Id(), err)
+	}
+
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("folder_id", folderID)
+	d.Set("member_id", memberID)
+	d.Set("member_type", memberType)
+
+	return nil
+}
+
+func resourceFolderMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountID, folderID, memberType, memberID, err := FolderMembershipParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting QuickSight Folder Membership: %s", d.Id())
+	_, err = conn.DeleteFolderMembershipWithContext(ctx, &quicksight.DeleteFolderMembershipInput{
+		AwsAccountId: aws.String(awsAccountID),
+		FolderId:     aws.String(folderID),
+		MemberId:     aws.String(memberID),
+		MemberType:   aws.String(memberType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Folder Membership (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const folderMembershipIDSeparator = "/"
+
+func FolderMembershipCreateID(awsAccountID, folderID, memberType, memberID string) string {
+	return strings.Join([]string{awsAccountID, folderID, memberType, memberID}, folderMembershipIDSeparator)
+}
+
+func FolderMembershipParseID(id string) (string, string, string, string, error) {
+	parts := strings.SplitN(id, folderMembershipIDSeparator, 4)
+
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID%[2]sFOLDER_ID%[2]sMEMBER_TYPE%[2]sMEMBER_ID", id, folderMembershipIDSeparator)
+	}
+
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
@@ -0,0 +1,146 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQuickSightFolderMembership_basic(t *testing.T) {
+	resourceName := "aws_quicksight_folder_membership.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSetID := os.Getenv("AWS_QUICKSIGHT_DATA_SET_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckDataSetID(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFolderMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderMembershipConfig(rName, dataSetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderMembershipExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "folder_id", "aws_quicksight_folder.test", "folder_id"),
+					resource.TestCheckResourceAttr(resourceName, "member_id", dataSetID),
+					resource.TestCheckResourceAttr(resourceName, "member_type", quicksight.MemberTypeDataset),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightFolderMembership_disappears(t *testing.T) {
+	resourceName := "aws_quicksight_folder_membership.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSetID := os.Getenv("AWS_QUICKSIGHT_DATA_SET_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckDataSetID(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFolderMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderMembershipConfig(rName, dataSetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFolderMembershipExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfquicksight.ResourceFolderMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPreCheckDataSetID(t *testing.T) {
+	if os.Getenv("AWS_QUICKSIGHT_DATA_SET_ID") == "" {
+		t.Skip("AWS_QUICKSIGHT_DATA_SET_ID env var must be set for QuickSight Folder Membership acceptance tests. " +
+			"This is required until QuickSight data sets can be managed by this provider.")
+	}
+}
+
+func testAccCheckFolderMembershipExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight Folder Membership ID is set")
+		}
+
+		awsAccountID, folderID, _, memberID, err := tfquicksight.FolderMembershipParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+		_, err = tfquicksight.FindFolderMembershipByID(context.Background(), conn, awsAccountID, folderID, memberID)
+
+		return err
+	}
+}
+
+func testAccCheckFolderMembershipDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_quicksight_folder_membership" {
+			continue
+		}
+
+		awsAccountID, folderID, _, memberID, err := tfquicksight.FolderMembershipParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfquicksight.FindFolderMembershipByID(context.Background(), conn, awsAccountID, folderID, memberID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("QuickSight Folder Membership %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccFolderMembershipConfig(rName, dataSetID string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_folder" "test" {
+  folder_id = %[1]q
+  name      = %[1]q
+}
+
+resource "aws_quicksight_folder_membership" "test" {
+  folder_id   = aws_quicksight_folder.test.folder_id
+  member_id   = %[2]q
+  member_type = "DATASET"
+}
+`, rName, dataSetID)
+}
@@ -0,0 +1,231 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNamespace() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNamespaceCreate,
+		ReadWithoutTimeout:   resourceNamespaceRead,
+		UpdateWithoutTimeout: resourceNamespaceUpdate,
+		DeleteWithoutTimeout: resourceNamespaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(namespaceCreatedTimeout),
+			Delete: schema.DefaultTimeout(namespaceDeletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"capacity_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"identity_store": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      quicksight.IdentityStoreQuicksight,
+				ValidateFunc: validation.StringInSlice(quicksight.IdentityStore_Values(), false),
+			},
+
+			"namespace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validNamespace,
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountID := meta.(*conns.AWSClient).AccountID
+	namespace := d.Get("namespace").(string)
+
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountID = v.(string)
+	}
+
+	input := &quicksight.CreateNamespaceInput{
+		AwsAccountId:  aws.String(awsAccountID),
+		IdentityStore: aws.String(d.Get("identity_store").(string)),
+		Namespace:     aws.String(namespace),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating QuickSight Namespace: %s", input)
+	_, err := conn.CreateNamespaceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating QuickSight Namespace (%s): %s", namespace, err)
+	}
+
+	d.SetId(NamespaceCreateID(awsAccountID, namespace))
+
+	if _, err := waitNamespaceCreated(ctx, conn, awsAccountID, namespace, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for QuickSight Namespace (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceNamespaceRead(ctx, d, meta)
+}
+
+func resourceNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountID, namespace, err := NamespaceParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindNamespaceByID(ctx, conn, awsAccountID, namespace)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Namespace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading QuickSight Namespace (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("aws_account_id", awsAccountID)
+	d.Set("capacity_region", output.CapacityRegion)
+	d.Set("creation_status", output.CreationStatus)
+	d.Set("identity_store", output.IdentityStore)
+	d.Set("namespace", output.Name)
+
+	tags, err := ListTags(conn, aws.StringValue(output.Arn))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for QuickSight Namespace (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating QuickSight Namespace (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNamespaceRead(ctx, d, meta)
+}
+
+func resourceNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn
+
+	awsAccountID, namespace, err := NamespaceParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting QuickSight Namespace: %s", d.Id())
+	_, err = conn.DeleteNamespaceWithContext(ctx, &quicksight.DeleteNamespaceInput{
+		AwsAccountId: aws.String(awsAccountID),
+		Namespace:    aws.String(namespace),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting QuickSight Namespace (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitNamespaceDeleted(ctx, conn, awsAccountID, namespace, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for QuickSight Namespace (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const namespaceIDSeparator = "/"
+
+func NamespaceCreateID(awsAccountID, namespace string) string {
+	return strings.Join([]string{awsAccountID, namespace}, namespaceIDSeparator)
+}
+
+func NamespaceParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, namespaceIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID%sNAMESPACE", id, namespaceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
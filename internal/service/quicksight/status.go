@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // status fetches the DataSource and its Status
@@ -29,3 +30,19 @@ func status(ctx context.Context, conn *quicksight.QuickSight, accountId, datasou
 		return output.DataSource, aws.StringValue(output.DataSource.Status), nil
 	}
 }
+
+func statusNamespace(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, namespace string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindNamespaceByID(ctx, conn, awsAccountID, namespace)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.CreationStatus), nil
+	}
+}
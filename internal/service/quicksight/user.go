@@ -56,13 +56,11 @@ func ResourceUser() *schema.Resource {
 			},
 
 			"namespace": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Default:  "default",
-				ValidateFunc: validation.StringInSlice([]string{
-					"default",
-				}, false),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      DefaultNamespace,
+				ValidateFunc: validNamespace,
 			},
 
 			"session_name": {
@@ -16,6 +16,11 @@ const (
 	dataSourceUpdateTimeout = 5 * time.Minute
 )
 
+const (
+	namespaceCreatedTimeout = 2 * time.Minute
+	namespaceDeletedTimeout = 2 * time.Minute
+)
+
 // waitCreated waits for a DataSource to return CREATION_SUCCESSFUL
 func waitCreated(ctx context.Context, conn *quicksight.QuickSight, accountId, dataSourceId string) (*quicksight.DataSource, error) {
 	stateConf := &resource.StateChangeConf{
@@ -59,3 +64,45 @@ func waitUpdated(ctx context.Context, conn *quicksight.QuickSight, accountId, da
 
 	return nil, err
 }
+
+func waitNamespaceCreated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, namespace string, timeout time.Duration) (*quicksight.NamespaceInfoV2, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.NamespaceStatusCreating},
+		Target:  []string{quicksight.NamespaceStatusCreated},
+		Refresh: statusNamespace(ctx, conn, awsAccountID, namespace),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.NamespaceInfoV2); ok {
+		if namespaceError := output.NamespaceError; namespaceError != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(namespaceError.Type), aws.StringValue(namespaceError.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitNamespaceDeleted(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, namespace string, timeout time.Duration) (*quicksight.NamespaceInfoV2, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.NamespaceStatusDeleting},
+		Target:  []string{},
+		Refresh: statusNamespace(ctx, conn, awsAccountID, namespace),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.NamespaceInfoV2); ok {
+		if namespaceError := output.NamespaceError; namespaceError != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(namespaceError.Type), aws.StringValue(namespaceError.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -208,6 +208,11 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"maintenance_track_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"master_password": {
 				Type:      schema.TypeString,
 				Optional:  true,
@@ -289,6 +294,15 @@ func ResourceCluster() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"manual_snapshot_retention_period": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  -1,
+							ValidateFunc: validation.Any(
+								validation.IntInSlice([]int{-1}),
+								validation.IntBetween(1, 3653),
+							),
+						},
 						"retention_period": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -342,6 +356,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			AutomatedSnapshotRetentionPeriod: aws.Int64(int64(d.Get("automated_snapshot_retention_period").(int))),
 		}
 
+		if v, ok := d.GetOk("maintenance_track_name"); ok {
+			restoreOpts.MaintenanceTrackName = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("owner_account"); ok {
 			restoreOpts.OwnerAccount = aws.String(v.(string))
 		}
@@ -446,6 +464,10 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			createOpts.AvailabilityZone = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("maintenance_track_name"); ok {
+			createOpts.MaintenanceTrackName = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("preferred_maintenance_window"); ok {
 			createOpts.PreferredMaintenanceWindow = aws.String(v.(string))
 		}
@@ -571,6 +593,12 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("logging", flattenLogging(loggingStatus)); err != nil {
 		return fmt.Errorf("error setting logging: %w", err)
 	}
+	// A new maintenance track is only applied during the next maintenance window.
+	if v := rsc.PendingModifiedValues; v != nil && v.MaintenanceTrackName != nil {
+		d.Set("maintenance_track_name", v.MaintenanceTrackName)
+	} else {
+		d.Set("maintenance_track_name", rsc.MaintenanceTrackName)
+	}
 	d.Set("master_username", rsc.MasterUsername)
 	d.Set("node_type", rsc.NodeType)
 	d.Set("number_of_nodes", rsc.NumberOfNodes)
@@ -691,6 +719,11 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
+	if d.HasChange("maintenance_track_name") {
+		req.MaintenanceTrackName = aws.String(d.Get("maintenance_track_name").(string))
+		requestUpdate = true
+	}
+
 	if d.HasChange("cluster_version") {
 		req.ClusterVersion = aws.String(d.Get("cluster_version").(string))
 		requestUpdate = true
@@ -777,10 +810,15 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("maintenance_track_name") {
+		if _, err := waitClusterMaintenanceTrackNameUpdated(conn, d.Id(), d.Get("maintenance_track_name").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Redshift Cluster (%s) maintenance track update: %w", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("snapshot_copy") {
-		if v, ok := d.GetOk("snapshot_copy"); ok {
-			err := enableRedshiftSnapshotCopy(d.Id(), v.([]interface{}), conn)
-			if err != nil {
+		if _, ok := d.GetOk("snapshot_copy"); ok {
+			if err := updateRedshiftSnapshotCopy(d, conn); err != nil {
 				return err
 			}
 		} else {
@@ -865,6 +903,9 @@ func enableRedshiftSnapshotCopy(id string, scList []interface{}, conn *redshift.
 	if gn, ok := sc["grant_name"]; ok {
 		input.SnapshotCopyGrantName = aws.String(gn.(string))
 	}
+	if v, ok := sc["manual_snapshot_retention_period"]; ok {
+		input.ManualSnapshotRetentionPeriod = aws.Int64(int64(v.(int)))
+	}
 
 	_, err := conn.EnableSnapshotCopy(&input)
 	if err != nil {
@@ -873,6 +914,61 @@ func enableRedshiftSnapshotCopy(id string, scList []interface{}, conn *redshift.
 	return nil
 }
 
+// updateRedshiftSnapshotCopy applies changes to an enabled snapshot copy configuration.
+// Retention periods are modified in place, other changes require snapshot copy to be disabled and re-enabled.
+func updateRedshiftSnapshotCopy(d *schema.ResourceData, conn *redshift.Redshift) error {
+	o, n := d.GetChange("snapshot_copy")
+	oList, nList := o.([]interface{}), n.([]interface{})
+
+	if len(oList) == 0 || oList[0] == nil {
+		return enableRedshiftSnapshotCopy(d.Id(), nList, conn)
+	}
+
+	oMap, nMap := oList[0].(map[string]interface{}), nList[0].(map[string]interface{})
+
+	if oMap["destination_region"] != nMap["destination_region"] || oMap["grant_name"] != nMap["grant_name"] {
+		_, err := conn.DisableSnapshotCopy(&redshift.DisableSnapshotCopyInput{
+			ClusterIdentifier: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to disable snapshot copy: %s", err)
+		}
+
+		return enableRedshiftSnapshotCopy(d.Id(), nList, conn)
+	}
+
+	if v := nMap["retention_period"].(int); v != oMap["retention_period"].(int) {
+		if err := modifyRedshiftSnapshotCopyRetentionPeriod(conn, d.Id(), v, false); err != nil {
+			return err
+		}
+	}
+
+	if v := nMap["manual_snapshot_retention_period"].(int); v != oMap["manual_snapshot_retention_period"].(int) {
+		if err := modifyRedshiftSnapshotCopyRetentionPeriod(conn, d.Id(), v, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func modifyRedshiftSnapshotCopyRetentionPeriod(conn *redshift.Redshift, id string, retentionPeriod int, manual bool) error {
+	input := &redshift.ModifySnapshotCopyRetentionPeriodInput{
+		ClusterIdentifier: aws.String(id),
+		Manual:            aws.Bool(manual),
+		RetentionPeriod:   aws.Int64(int64(retentionPeriod)),
+	}
+
+	log.Printf("[DEBUG] Modifying Redshift Cluster snapshot copy retention period: %s", input)
+	_, err := conn.ModifySnapshotCopyRetentionPeriod(input)
+
+	if err != nil {
+		return fmt.Errorf("error modifying Redshift Cluster (%s) snapshot copy retention period: %w", id, err)
+	}
+
+	return nil
+}
+
 func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RedshiftConn
 
@@ -37,3 +37,8 @@ func clusterType_Values() []string {
 		clusterTypeSingleNode,
 	}
 }
+
+const (
+	clusterMaintenanceTrackNameStatusPending = "pending"
+	clusterMaintenanceTrackNameStatusUpdated = "updated"
+)
@@ -65,6 +65,9 @@ func flattenSnapshotCopy(scs *redshift.ClusterSnapshotCopyStatus) []interface{}
 	if scs.DestinationRegion != nil {
 		cfg["destination_region"] = *scs.DestinationRegion
 	}
+	if scs.ManualSnapshotRetentionPeriod != nil {
+		cfg["manual_snapshot_retention_period"] = *scs.ManualSnapshotRetentionPeriod
+	}
 	if scs.RetentionPeriod != nil {
 		cfg["retention_period"] = *scs.RetentionPeriod
 	}
@@ -22,3 +22,30 @@ func statusCluster(conn *redshift.Redshift, id string) resource.StateRefreshFunc
 		return output, aws.StringValue(output.ClusterStatus), nil
 	}
 }
+
+// statusClusterMaintenanceTrackName reports whether the cluster's current or pending maintenance track is the specified track.
+func statusClusterMaintenanceTrackName(conn *redshift.Redshift, id, trackName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindClusterByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		current := aws.StringValue(output.MaintenanceTrackName)
+
+		if v := output.PendingModifiedValues; v != nil && v.MaintenanceTrackName != nil {
+			current = aws.StringValue(v.MaintenanceTrackName)
+		}
+
+		if current != trackName {
+			return output, clusterMaintenanceTrackNameStatusPending, nil
+		}
+
+		return output, clusterMaintenanceTrackNameStatusUpdated, nil
+	}
+}
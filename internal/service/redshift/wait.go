@@ -35,3 +35,20 @@ func waitClusterDeleted(conn *redshift.Redshift, id string, timeout time.Duratio
 
 	return nil, err
 }
+
+func waitClusterMaintenanceTrackNameUpdated(conn *redshift.Redshift, id, trackName string, timeout time.Duration) (*redshift.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{clusterMaintenanceTrackNameStatusPending},
+		Target:  []string{clusterMaintenanceTrackNameStatusUpdated},
+		Refresh: statusClusterMaintenanceTrackName(conn, id, trackName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*redshift.Cluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
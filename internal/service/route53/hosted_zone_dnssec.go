@@ -24,6 +24,11 @@ func ResourceHostedZoneDNSSEC() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"ds_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"hosted_zone_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -101,6 +106,17 @@ func resourceHostedZoneDNSSECRead(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 
+	var dsRecords []string
+
+	for _, keySigningKey := range hostedZoneDnssec.KeySigningKeys {
+		if keySigningKey == nil || aws.StringValue(keySigningKey.Status) != KeySigningKeyStatusActive {
+			continue
+		}
+
+		dsRecords = append(dsRecords, aws.StringValue(keySigningKey.DSRecord))
+	}
+
+	d.Set("ds_records", dsRecords)
 	d.Set("hosted_zone_id", d.Id())
 
 	if hostedZoneDnssec.Status != nil {
@@ -154,6 +170,10 @@ func resourceHostedZoneDNSSECDelete(d *schema.ResourceData, meta interface{}) er
 		return nil
 	}
 
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeKeySigningKeyInParentDSRecord) {
+		return fmt.Errorf("error disabling Route 53 Hosted Zone DNSSEC (%s): remove the DS record from the parent zone and wait for its TTL to expire first: %w", d.Id(), err)
+	}
+
 	if err != nil {
 		return fmt.Errorf("error disabling Route 53 Hosted Zone DNSSEC (%s): %w", d.Id(), err)
 	}
@@ -32,6 +32,8 @@ func TestAccRoute53HostedZoneDNSSEC_basic(t *testing.T) {
 				Config: testAccHostedZoneDNSSECConfig(rName, domainName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccHostedZoneDNSSECExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ds_records.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "ds_records.0", "aws_route53_key_signing_key.test", "ds_record"),
 					resource.TestCheckResourceAttrPair(resourceName, "hosted_zone_id", route53ZoneResourceName, "id"),
 					resource.TestCheckResourceAttr(resourceName, "signing_status", tfroute53.ServeSignatureSigning),
 				),
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -114,6 +115,10 @@ func resourceKeySigningKeyCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if v, ok := d.GetOk("key_management_service_arn"); ok {
+		if err := validateKeySigningKeyKMSKey(meta, v.(string)); err != nil {
+			return fmt.Errorf("error creating Route 53 Key Signing Key: %w", err)
+		}
+
 		input.KeyManagementServiceArn = aws.String(v.(string))
 	}
 
@@ -249,30 +254,50 @@ func resourceKeySigningKeyUpdate(d *schema.ResourceData, meta interface{}) error
 func resourceKeySigningKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).Route53Conn
 
-	status := d.Get("status").(string)
+	hostedZoneID := d.Get("hosted_zone_id").(string)
+	name := d.Get("name").(string)
+
+	keySigningKey, err := FindKeySigningKey(conn, hostedZoneID, name)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) || (err == nil && keySigningKey == nil) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Key Signing Key (%s): %w", d.Id(), err)
+	}
 
-	if status == KeySigningKeyStatusActive || status == KeySigningKeyStatusActionNeeded {
+	// A key signing key must be deactivated before it can be deleted.
+	// The last active key cannot be deactivated while the hosted zone is signing,
+	// so retry while DNSSEC signing is disabled, e.g. by aws_route53_hosted_zone_dnssec being destroyed.
+	if status := aws.StringValue(keySigningKey.Status); status == KeySigningKeyStatusActive || status == KeySigningKeyStatusActionNeeded {
 		input := &route53.DeactivateKeySigningKeyInput{
-			HostedZoneId: aws.String(d.Get("hosted_zone_id").(string)),
-			Name:         aws.String(d.Get("name").(string)),
+			HostedZoneId: aws.String(hostedZoneID),
+			Name:         aws.String(name),
 		}
 
-		output, err := conn.DeactivateKeySigningKey(input)
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(keySigningKeyStatusTimeout, func() (interface{}, error) {
+			return conn.DeactivateKeySigningKey(input)
+		}, route53.ErrCodeKeySigningKeyInUse)
 
 		if err != nil {
-			return fmt.Errorf("error updating Route 53 Key Signing Key (%s) status (%s): %w", d.Id(), status, err)
+			return fmt.Errorf("error deactivating Route 53 Key Signing Key (%s): %w", d.Id(), err)
 		}
 
-		if output != nil && output.ChangeInfo != nil {
+		if output := outputRaw.(*route53.DeactivateKeySigningKeyOutput); output != nil && output.ChangeInfo != nil {
 			if _, err := waitChangeInfoStatusInsync(conn, aws.StringValue(output.ChangeInfo.Id)); err != nil {
-				return fmt.Errorf("error waiting for Route 53 Key Signing Key (%s) status (%s) update: %w", d.Id(), status, err)
+				return fmt.Errorf("error waiting for Route 53 Key Signing Key (%s) deactivation: %w", d.Id(), err)
 			}
 		}
+
+		if _, err := waitKeySigningKeyStatusUpdated(conn, hostedZoneID, name, KeySigningKeyStatusInactive); err != nil {
+			return fmt.Errorf("error waiting for Route 53 Key Signing Key (%s) status (%s): %w", d.Id(), KeySigningKeyStatusInactive, err)
+		}
 	}
 
 	input := &route53.DeleteKeySigningKeyInput{
-		HostedZoneId: aws.String(d.Get("hosted_zone_id").(string)),
-		Name:         aws.String(d.Get("name").(string)),
+		HostedZoneId: aws.String(hostedZoneID),
+		Name:         aws.String(name),
 	}
 
 	output, err := conn.DeleteKeySigningKey(input)
@@ -286,7 +311,7 @@ func resourceKeySigningKeyDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting Route 53 Key Signing Key (%s), status (%s): %w", d.Id(), d.Get("status").(string), err)
+		return fmt.Errorf("error deleting Route 53 Key Signing Key (%s): %w", d.Id(), err)
 	}
 
 	if output != nil && output.ChangeInfo != nil {
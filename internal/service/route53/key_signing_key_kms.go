@@ -0,0 +1,187 @@
+package route53
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+)
+
+const (
+	keySigningKeyKMSKeyPolicyName = "default"
+)
+
+// keySigningKeyServicePrincipals are the service principals Route 53 uses to access DNSSEC signing keys.
+// The second is the original principal documented when DNSSEC signing was launched.
+var keySigningKeyServicePrincipals = []string{
+	"dnssec-route53.amazonaws.com",
+	"api-service.dnssec.route53.aws.internal",
+}
+
+// validateKeySigningKeyKMSKey checks that a KMS key can be used by Route 53 for DNSSEC signing.
+// Problems that can only be detected with permissions the caller lacks are logged and ignored.
+func validateKeySigningKeyKMSKey(meta interface{}, keyARN string) error {
+	parsedARN, err := arn.Parse(keyARN)
+
+	if err != nil {
+		return fmt.Errorf("error parsing KMS key ARN (%s): %w", keyARN, err)
+	}
+
+	client := meta.(*conns.AWSClient)
+	sess, err := client.NewSessionForRegion(&client.KMSConn.Config, parsedARN.Region)
+
+	if err != nil {
+		return fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	conn := kms.New(sess)
+
+	key, err := tfkms.FindKeyByID(conn, keyARN)
+
+	if tfawserr.ErrCodeEquals(err, "AccessDeniedException") {
+		log.Printf("[WARN] Unable to validate KMS key (%s) for Route 53 DNSSEC signing: %s", keyARN, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS key (%s): %w", keyARN, err)
+	}
+
+	if v := aws.StringValue(key.KeyState); v != kms.KeyStateEnabled {
+		return fmt.Errorf("KMS key (%s) must be enabled, got key state %s", keyARN, v)
+	}
+
+	if v := aws.StringValue(key.KeyUsage); v != kms.KeyUsageTypeSignVerify {
+		return fmt.Errorf("KMS key (%s) must have key usage %s, got %s", keyARN, kms.KeyUsageTypeSignVerify, v)
+	}
+
+	keySpec := aws.StringValue(key.KeySpec)
+
+	if keySpec == "" {
+		keySpec = aws.StringValue(key.CustomerMasterKeySpec)
+	}
+
+	if v := keySpec; v != kms.KeySpecEccNistP256 {
+		return fmt.Errorf("KMS key (%s) must have key spec %s, got %s", keyARN, kms.KeySpecEccNistP256, v)
+	}
+
+	policy, err := tfkms.FindKeyPolicyByKeyIDAndPolicyName(conn, keyARN, keySigningKeyKMSKeyPolicyName)
+
+	if tfawserr.ErrCodeEquals(err, "AccessDeniedException") {
+		log.Printf("[WARN] Unable to validate KMS key (%s) policy for Route 53 DNSSEC signing: %s", keyARN, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS key (%s) policy: %w", keyARN, err)
+	}
+
+	ok, err := keyPolicyAllowsServicePrincipal(aws.StringValue(policy), keySigningKeyServicePrincipals, "kms:Sign")
+
+	if err != nil {
+		return fmt.Errorf("error parsing KMS key (%s) policy: %w", keyARN, err)
+	}
+
+	if !ok {
+		return fmt.Errorf("KMS key (%s) policy must allow the %s service principal to use the key (kms:DescribeKey, kms:GetPublicKey and kms:Sign)", keyARN, keySigningKeyServicePrincipals[0])
+	}
+
+	return nil
+}
+
+// keyPolicyAllowsServicePrincipal returns whether a key policy contains an Allow statement
+// granting the action to any of the service principals. Conditions are not evaluated.
+func keyPolicyAllowsServicePrincipal(policy string, servicePrincipals []string, action string) (bool, error) {
+	var document struct {
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return false, err
+	}
+
+	type statement struct {
+		Effect    string
+		Principal interface{}
+		Action    interface{}
+	}
+
+	var statements []statement
+
+	if err := json.Unmarshal(document.Statement, &statements); err != nil {
+		var s statement
+
+		if err := json.Unmarshal(document.Statement, &s); err != nil {
+			return false, err
+		}
+
+		statements = []statement{s}
+	}
+
+	for _, s := range statements {
+		if s.Effect != "Allow" {
+			continue
+		}
+
+		principalMatch := false
+
+		switch v := s.Principal.(type) {
+		case string:
+			principalMatch = v == "*"
+		case map[string]interface{}:
+			principalMatch = policyValuesContain(v["Service"], func(s string) bool {
+				for _, servicePrincipal := range servicePrincipals {
+					if s == servicePrincipal {
+						return true
+					}
+				}
+
+				return false
+			})
+		}
+
+		if !principalMatch {
+			continue
+		}
+
+		if policyValuesContain(s.Action, func(s string) bool { return policyActionMatches(s, action) }) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func policyValuesContain(v interface{}, f func(string) bool) bool {
+	switch v := v.(type) {
+	case string:
+		return f(v)
+	case []interface{}:
+		for _, v := range v {
+			if s, ok := v.(string); ok && f(s) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func policyActionMatches(pattern, action string) bool {
+	if pattern == "*" {
+		return true
+	}
+
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(strings.ToLower(action), strings.ToLower(strings.TrimSuffix(pattern, "*")))
+	}
+
+	return strings.EqualFold(pattern, action)
+}
@@ -0,0 +1,104 @@
+package route53
+
+import (
+	"testing"
+)
+
+func TestKeyPolicyAllowsServicePrincipal(t *testing.T) {
+	servicePrincipals := []string{"dnssec-route53.amazonaws.com", "api-service.dnssec.route53.aws.internal"}
+
+	testCases := []struct {
+		name      string
+		policy    string
+		expected  bool
+		expectErr bool
+	}{
+		{
+			name:      "invalid JSON",
+			policy:    `{`,
+			expectErr: true,
+		},
+		{
+			name: "service principal",
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"Service": "dnssec-route53.amazonaws.com"},
+      "Action": ["kms:DescribeKey", "kms:GetPublicKey", "kms:Sign"],
+      "Resource": "*"
+    }
+  ]
+}`,
+			expected: true,
+		},
+		{
+			name: "legacy service principal list and wildcard action",
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": {"Service": ["ec2.amazonaws.com", "api-service.dnssec.route53.aws.internal"]},
+    "Action": "kms:*",
+    "Resource": "*"
+  }
+}`,
+			expected: true,
+		},
+		{
+			name: "wildcard principal",
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Principal": "*", "Action": "kms:Sign", "Resource": "*"}]
+}`,
+			expected: true,
+		},
+		{
+			name: "account principal only",
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::123456789012:root"}, "Action": "kms:*", "Resource": "*"}]
+}`,
+			expected: false,
+		},
+		{
+			name: "deny",
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Deny", "Principal": {"Service": "dnssec-route53.amazonaws.com"}, "Action": "kms:Sign", "Resource": "*"}]
+}`,
+			expected: false,
+		},
+		{
+			name: "missing sign action",
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Principal": {"Service": "dnssec-route53.amazonaws.com"}, "Action": ["kms:DescribeKey", "kms:GetPublicKey"], "Resource": "*"}]
+}`,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := keyPolicyAllowsServicePrincipal(testCase.policy, servicePrincipals, "kms:Sign")
+
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -132,6 +132,29 @@ func TestAccRoute53KeySigningKey_status(t *testing.T) {
 	})
 }
 
+func TestAccRoute53KeySigningKey_kmsKeyValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckRoute53KeySigningKey(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckKeySigningKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeySigningKeyConfig_SymmetricKMSKey(rName, domainName),
+				ExpectError: regexp.MustCompile(`must have key usage SIGN_VERIFY`),
+			},
+			{
+				Config:      testAccKeySigningKeyConfig_KMSKeyPolicyWithoutServicePrincipal(rName, domainName),
+				ExpectError: regexp.MustCompile(`policy must allow the dnssec-route53.amazonaws.com service principal`),
+			},
+		},
+	})
+}
+
 func testAccCheckKeySigningKeyDestroy(s *terraform.State) error {
 	conn := testAccProviderRoute53KeySigningKey.Meta().(*conns.AWSClient).Route53Conn
 
@@ -244,6 +267,48 @@ resource "aws_route53_key_signing_key" "test" {
 `, rName))
 }
 
+func testAccKeySigningKeyConfig_SymmetricKMSKey(rName, domainName string) string {
+	return acctest.ConfigCompose(
+		testAccRoute53KeySigningKeyRegionProviderConfig(),
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_route53_zone" "test" {
+  name = %[2]q
+}
+
+resource "aws_route53_key_signing_key" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test.arn
+  name                       = %[1]q
+}
+`, rName, domainName))
+}
+
+func testAccKeySigningKeyConfig_KMSKeyPolicyWithoutServicePrincipal(rName, domainName string) string {
+	return acctest.ConfigCompose(
+		testAccRoute53KeySigningKeyRegionProviderConfig(),
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  customer_master_key_spec = "ECC_NIST_P256"
+  deletion_window_in_days  = 7
+  key_usage                = "SIGN_VERIFY"
+}
+
+resource "aws_route53_zone" "test" {
+  name = %[2]q
+}
+
+resource "aws_route53_key_signing_key" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test.arn
+  name                       = %[1]q
+}
+`, rName, domainName))
+}
+
 func testAccKeySigningKeyConfig_Status(rName, domainName, status string) string {
 	return acctest.ConfigCompose(
 		testAccKeySigningKeyConfig_Base(rName, domainName),
@@ -75,8 +75,9 @@ func ResourceBucketIntelligentTieringConfiguration() *schema.Resource {
 							ValidateFunc: validation.StringInSlice(s3.IntelligentTieringAccessTier_Values(), false),
 						},
 						"days": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(90, 730),
 						},
 					},
 				},
@@ -166,7 +166,7 @@ func ResourceBucketInventory() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice(s3.InventoryOptionalField_Values(), false),
+					ValidateFunc: validation.StringInSlice(InventoryOptionalField_Values(), false),
 				},
 				Set: schema.HashString,
 			},
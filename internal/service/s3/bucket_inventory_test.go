@@ -125,6 +125,43 @@ func TestAccS3BucketInventory_encryptWithSSEKMS(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketInventory_optionalFields(t *testing.T) {
+	var conf s3.InventoryConfiguration
+	rString := sdkacctest.RandString(8)
+	resourceName := "aws_s3_bucket_inventory.test"
+
+	bucketName := fmt.Sprintf("tf-acc-bucket-inventory-%s", rString)
+	inventoryName := t.Name()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketInventoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketInventoryOptionalFieldsConfig(bucketName, inventoryName, `"Size", "NotAField"`),
+				ExpectError: regexp.MustCompile(`expected optional_fields.\d+ to be one of`),
+			},
+			{
+				Config: testAccBucketInventoryOptionalFieldsConfig(bucketName, inventoryName, `"ChecksumAlgorithm", "ObjectAccessControlList", "ObjectOwner"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketInventoryExistsConfig(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "optional_fields.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", tfs3.InventoryOptionalFieldChecksumAlgorithm),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", tfs3.InventoryOptionalFieldObjectAccessControlList),
+					resource.TestCheckTypeSetElemAttr(resourceName, "optional_fields.*", tfs3.InventoryOptionalFieldObjectOwner),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckBucketInventoryExistsConfig(n string, res *s3.InventoryConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -302,3 +339,27 @@ resource "aws_s3_bucket_inventory" "test" {
 }
 `, bucketName, inventoryName)
 }
+
+func testAccBucketInventoryOptionalFieldsConfig(bucketName, inventoryName, optionalFields string) string {
+	return testAccBucketInventoryBucketConfig(bucketName) + fmt.Sprintf(`
+resource "aws_s3_bucket_inventory" "test" {
+  bucket = aws_s3_bucket.test.id
+  name   = %[1]q
+
+  included_object_versions = "All"
+
+  optional_fields = [%[2]s]
+
+  schedule {
+    frequency = "Weekly"
+  }
+
+  destination {
+    bucket {
+      format     = "CSV"
+      bucket_arn = aws_s3_bucket.test.arn
+    }
+  }
+}
+`, inventoryName, optionalFields)
+}
@@ -114,14 +114,16 @@ func ResourceBucketLifecycleConfiguration() *schema.Resource {
 										},
 									},
 									"object_size_greater_than": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  0, // API returns 0
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      0, // API returns 0
+										ValidateFunc: validation.IntAtLeast(0),
 									},
 									"object_size_less_than": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  0, // API returns 0
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      0, // API returns 0
+										ValidateFunc: validation.IntAtLeast(0),
 									},
 									"prefix": {
 										Type:     schema.TypeString,
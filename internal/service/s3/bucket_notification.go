@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -134,7 +135,93 @@ func ResourceBucketNotification() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceBucketNotificationCustomizeDiff,
+	}
+}
+
+func resourceBucketNotificationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	type notificationFilter struct {
+		address string
+		events  []string
+		prefix  string
+		suffix  string
+	}
+
+	var filters []notificationFilter
+
+	for _, key := range []string{"topic", "queue", "lambda_function"} {
+		for i, v := range diff.Get(key).([]interface{}) {
+			c, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			address := fmt.Sprintf("%s.%d", key, i)
+
+			// Values that are not yet known are treated as empty strings, which would match everything.
+			if !diff.NewValueKnown(address+".filter_prefix") || !diff.NewValueKnown(address+".filter_suffix") || !diff.NewValueKnown(address+".events") {
+				continue
+			}
+
+			filter := notificationFilter{
+				address: address,
+				prefix:  c["filter_prefix"].(string),
+				suffix:  c["filter_suffix"].(string),
+			}
+
+			if v, ok := c["events"].(*schema.Set); ok {
+				filter.events = aws.StringValueSlice(flex.ExpandStringSet(v))
+			}
+
+			filters = append(filters, filter)
+		}
 	}
+
+	// S3 rejects configurations whose key filters overlap for the same event type.
+	for i := 0; i < len(filters); i++ {
+		for j := i + 1; j < len(filters); j++ {
+			f1, f2 := filters[i], filters[j]
+
+			if !notificationEventsOverlap(f1.events, f2.events) {
+				continue
+			}
+
+			if (strings.HasPrefix(f1.prefix, f2.prefix) || strings.HasPrefix(f2.prefix, f1.prefix)) &&
+				(strings.HasSuffix(f1.suffix, f2.suffix) || strings.HasSuffix(f2.suffix, f1.suffix)) {
+				return fmt.Errorf("%s and %s have overlapping filter_prefix and filter_suffix values for the same event types", f1.address, f2.address)
+			}
+		}
+	}
+
+	return nil
+}
+
+// notificationEventsOverlap returns whether any event in one list matches an event in the other,
+// taking wildcard event types such as "s3:ObjectCreated:*" into account.
+func notificationEventsOverlap(events1, events2 []string) bool {
+	matches := func(e1, e2 string) bool {
+		if e1 == e2 {
+			return true
+		}
+
+		if strings.HasSuffix(e1, "*") && strings.HasPrefix(e2, strings.TrimSuffix(e1, "*")) {
+			return true
+		}
+
+		return false
+	}
+
+	for _, e1 := range events1 {
+		for _, e2 := range events2 {
+			if matches(e1, e2) || matches(e2, e1) {
+				return true
+			}
+		}
+	}
+
+	return false
 }
 
 func resourceBucketNotificationPut(d *schema.ResourceData, meta interface{}) error {
@@ -3,6 +3,7 @@ package s3_test
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 	"time"
@@ -291,6 +292,23 @@ func TestAccS3BucketNotification_update(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketNotification_overlappingFilters(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketNotificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketNotificationOverlappingFiltersConfig(rName),
+				ExpectError: regexp.MustCompile(`topic.0 and queue.0 have overlapping filter_prefix and filter_suffix values`),
+			},
+		},
+	})
+}
+
 func testAccCheckBucketNotificationDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
 
@@ -857,3 +875,34 @@ resource "aws_s3_bucket_notification" "notification" {
 }
 `, rName)
 }
+
+func testAccBucketNotificationOverlappingFiltersConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_notification" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  topic {
+    topic_arn     = "arn:${data.aws_partition.current.partition}:sns:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s"
+    events        = ["s3:ObjectCreated:*"]
+    filter_prefix = "images/"
+  }
+
+  queue {
+    queue_arn     = "arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s"
+    events        = ["s3:ObjectCreated:Put"]
+    filter_prefix = "images/thumbnails/"
+    filter_suffix = ".png"
+  }
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, rName)
+}
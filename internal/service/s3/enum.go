@@ -13,6 +13,10 @@ const (
 
 	LifecycleRuleStatusEnabled  = "Enabled"
 	LifecycleRuleStatusDisabled = "Disabled"
+
+	InventoryOptionalFieldChecksumAlgorithm       = "ChecksumAlgorithm"
+	InventoryOptionalFieldObjectAccessControlList = "ObjectAccessControlList"
+	InventoryOptionalFieldObjectOwner             = "ObjectOwner"
 )
 
 func BucketCannedACL_Values() []string {
@@ -22,6 +26,14 @@ func BucketCannedACL_Values() []string {
 	return result
 }
 
+func InventoryOptionalField_Values() []string {
+	result := s3.InventoryOptionalField_Values()
+	result = appendUniqueString(result, InventoryOptionalFieldChecksumAlgorithm)
+	result = appendUniqueString(result, InventoryOptionalFieldObjectAccessControlList)
+	result = appendUniqueString(result, InventoryOptionalFieldObjectOwner)
+	return result
+}
+
 func appendUniqueString(slice []string, elem string) []string {
 	for _, e := range slice {
 		if e == elem {
@@ -212,7 +212,7 @@ func ExpandLifecycleRuleExpiration(l []interface{}) (*s3.LifecycleExpiration, er
 	return result, nil
 }
 
-// ExpandLifecycleRuleFilter ensures a Filter can have only 1 of prefix, tag, or and
+// ExpandLifecycleRuleFilter ensures a Filter can have only 1 of prefix, tag, object size, or and
 func ExpandLifecycleRuleFilter(l []interface{}) *s3.LifecycleRuleFilter {
 	if len(l) == 0 {
 		return nil
@@ -245,7 +245,7 @@ func ExpandLifecycleRuleFilter(l []interface{}) *s3.LifecycleRuleFilter {
 		}
 	}
 
-	if v, ok := m["prefix"].(string); ok && result.And == nil && result.Tag == nil {
+	if v, ok := m["prefix"].(string); ok && result.And == nil && result.Tag == nil && result.ObjectSizeGreaterThan == nil && result.ObjectSizeLessThan == nil {
 		result.Prefix = aws.String(v)
 	}
 
@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
@@ -29,13 +30,15 @@ func DataSourceObjects() *schema.Resource {
 				Optional: true,
 			},
 			"encoding_type": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.EncodingType_Values(), false),
 			},
 			"max_keys": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  1000,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"start_after": {
 				Type:     schema.TypeString,
@@ -121,6 +124,10 @@ func dataSourceObjectsRead(d *schema.ResourceData, meta interface{}) error {
 
 		maxKeys = maxKeys - aws.Int64Value(page.KeyCount)
 
+		if maxKeys <= 0 {
+			return false
+		}
+
 		if maxKeys <= keyRequestPageSize {
 			listInput.MaxKeys = aws.Int64(maxKeys)
 		}
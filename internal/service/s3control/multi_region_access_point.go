@@ -263,7 +263,7 @@ func S3ControlConnForMRAP(client *conns.AWSClient) (*s3control.S3Control, error)
 		return originalConn, nil
 	}
 
-	sess, err := conns.NewSessionForRegion(&originalConn.Config, region, client.TerraformVersion)
+	sess, err := client.NewSessionForRegion(&originalConn.Config, region)
 
 	if err != nil {
 		return nil, fmt.Errorf("error creating AWS session: %w", err)
@@ -274,6 +274,75 @@ func ResourceDomain() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"domain_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"r_studio_server_pro_domain_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_resource_spec": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"instance_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(sagemaker.AppInstanceType_Values(), false),
+												},
+												"lifecycle_config_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"sagemaker_image_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"sagemaker_image_version_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"domain_execution_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"r_studio_connect_url": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"r_studio_package_manager_url": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 3,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"retention_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -322,6 +391,10 @@ func resourceDomainCreate(d *schema.ResourceData, meta interface{}) error {
 		DefaultUserSettings:  expandSagemakerDomainDefaultUserSettings(d.Get("default_user_settings").([]interface{})),
 	}
 
+	if v, ok := d.GetOk("domain_settings"); ok && len(v.([]interface{})) > 0 {
+		input.DomainSettings = expandSagemakerDomainSettings(v.([]interface{}))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -385,6 +458,10 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting default_user_settings for SageMaker domain (%s): %w", d.Id(), err)
 	}
 
+	if err := d.Set("domain_settings", flattenSagemakerDomainSettings(domain.DomainSettings)); err != nil {
+		return fmt.Errorf("error setting domain_settings for SageMaker domain (%s): %w", d.Id(), err)
+	}
+
 	tags, err := ListTags(conn, arn)
 
 	if err != nil {
@@ -408,12 +485,16 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 func resourceDomainUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SageMakerConn
 
-	if d.HasChange("default_user_settings") {
+	if d.HasChanges("default_user_settings", "domain_settings") {
 		input := &sagemaker.UpdateDomainInput{
 			DomainId:            aws.String(d.Id()),
 			DefaultUserSettings: expandSagemakerDomainDefaultUserSettings(d.Get("default_user_settings").([]interface{})),
 		}
 
+		if d.HasChange("domain_settings") {
+			input.DomainSettingsForUpdate = expandSagemakerDomainSettingsUpdate(d.Get("domain_settings").([]interface{}))
+		}
+
 		log.Printf("[DEBUG] sagemaker domain update config: %#v", *input)
 		_, err := conn.UpdateDomain(input)
 		if err != nil {
@@ -477,6 +558,73 @@ func expandSagemakerRetentionPolicy(l []interface{}) *sagemaker.RetentionPolicy
 	return config
 }
 
+func expandSagemakerDomainSettings(l []interface{}) *sagemaker.DomainSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.DomainSettings{}
+
+	if v, ok := m["r_studio_server_pro_domain_settings"].([]interface{}); ok && len(v) > 0 {
+		config.RStudioServerProDomainSettings = expandSagemakerRStudioServerProDomainSettings(v)
+	}
+
+	if v, ok := m["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		config.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	return config
+}
+
+func expandSagemakerDomainSettingsUpdate(l []interface{}) *sagemaker.DomainSettingsForUpdate {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.DomainSettingsForUpdate{}
+
+	if v, ok := m["r_studio_server_pro_domain_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rs := v[0].(map[string]interface{})
+
+		config.RStudioServerProDomainSettingsForUpdate = &sagemaker.RStudioServerProDomainSettingsForUpdate{
+			DefaultResourceSpec:    expandSagemakerDomainDefaultResourceSpec(rs["default_resource_spec"].([]interface{})),
+			DomainExecutionRoleArn: aws.String(rs["domain_execution_role_arn"].(string)),
+		}
+	}
+
+	return config
+}
+
+func expandSagemakerRStudioServerProDomainSettings(l []interface{}) *sagemaker.RStudioServerProDomainSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.RStudioServerProDomainSettings{
+		DomainExecutionRoleArn: aws.String(m["domain_execution_role_arn"].(string)),
+	}
+
+	if v, ok := m["default_resource_spec"].([]interface{}); ok && len(v) > 0 {
+		config.DefaultResourceSpec = expandSagemakerDomainDefaultResourceSpec(v)
+	}
+
+	if v, ok := m["r_studio_connect_url"].(string); ok && v != "" {
+		config.RStudioConnectUrl = aws.String(v)
+	}
+
+	if v, ok := m["r_studio_package_manager_url"].(string); ok && v != "" {
+		config.RStudioPackageManagerUrl = aws.String(v)
+	}
+
+	return config
+}
+
 func expandSagemakerDomainDefaultUserSettings(l []interface{}) *sagemaker.UserSettings {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -678,6 +826,34 @@ func flattenSagemakerDomainDefaultUserSettings(config *sagemaker.UserSettings) [
 	return []map[string]interface{}{m}
 }
 
+func flattenSagemakerDomainSettings(config *sagemaker.DomainSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"r_studio_server_pro_domain_settings": flattenSagemakerRStudioServerProDomainSettings(config.RStudioServerProDomainSettings),
+		"security_group_ids":                  flex.FlattenStringSet(config.SecurityGroupIds),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSagemakerRStudioServerProDomainSettings(config *sagemaker.RStudioServerProDomainSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"default_resource_spec":        flattenSagemakerDomainDefaultResourceSpec(config.DefaultResourceSpec),
+		"domain_execution_role_arn":    aws.StringValue(config.DomainExecutionRoleArn),
+		"r_studio_connect_url":         aws.StringValue(config.RStudioConnectUrl),
+		"r_studio_package_manager_url": aws.StringValue(config.RStudioPackageManagerUrl),
+	}
+
+	return []map[string]interface{}{m}
+}
+
 func flattenSagemakerDomainDefaultResourceSpec(config *sagemaker.ResourceSpec) []map[string]interface{} {
 	if config == nil {
 		return []map[string]interface{}{}
@@ -488,3 +488,28 @@ func FindProjectByName(conn *sagemaker.SageMaker, name string) (*sagemaker.Descr
 
 	return output, nil
 }
+
+func FindInferenceRecommendationsJobByName(conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeInferenceRecommendationsJobOutput, error) {
+	input := &sagemaker.DescribeInferenceRecommendationsJobInput{
+		JobName: aws.String(name),
+	}
+
+	output, err := conn.DescribeInferenceRecommendationsJob(input)
+
+	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,198 @@
+package sagemaker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceInferenceRecommendationsJob() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceInferenceRecommendationsJobRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"completion_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inference_recommendations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cost_per_hour": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"cost_per_inference": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"endpoint_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"environment_parameters": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"inference_specification_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"initial_instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_invocations": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"model_latency": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"variant_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"job_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"job_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_package_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceInferenceRecommendationsJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+
+	name := d.Get("job_name").(string)
+	job, err := FindInferenceRecommendationsJobByName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Inference Recommendations Job (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(job.JobName))
+	d.Set("arn", job.JobArn)
+	if job.CompletionTime != nil {
+		d.Set("completion_time", aws.TimeValue(job.CompletionTime).Format(time.RFC3339))
+	} else {
+		d.Set("completion_time", nil)
+	}
+	d.Set("creation_time", aws.TimeValue(job.CreationTime).Format(time.RFC3339))
+	d.Set("failure_reason", job.FailureReason)
+	d.Set("job_description", job.JobDescription)
+	d.Set("job_name", job.JobName)
+	d.Set("job_type", job.JobType)
+	if job.InputConfig != nil {
+		d.Set("model_package_version_arn", job.InputConfig.ModelPackageVersionArn)
+	} else {
+		d.Set("model_package_version_arn", nil)
+	}
+	d.Set("role_arn", job.RoleArn)
+	d.Set("status", job.Status)
+
+	if err := d.Set("inference_recommendations", flattenSagemakerInferenceRecommendations(job.InferenceRecommendations)); err != nil {
+		return fmt.Errorf("error setting inference_recommendations: %w", err)
+	}
+
+	return nil
+}
+
+func flattenSagemakerInferenceRecommendations(apiObjects []*sagemaker.InferenceRecommendation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.EndpointConfiguration; v != nil {
+			tfMap["endpoint_name"] = aws.StringValue(v.EndpointName)
+			tfMap["initial_instance_count"] = aws.Int64Value(v.InitialInstanceCount)
+			tfMap["instance_type"] = aws.StringValue(v.InstanceType)
+			tfMap["variant_name"] = aws.StringValue(v.VariantName)
+		}
+
+		if v := apiObject.Metrics; v != nil {
+			tfMap["cost_per_hour"] = aws.Float64Value(v.CostPerHour)
+			tfMap["cost_per_inference"] = aws.Float64Value(v.CostPerInference)
+			tfMap["max_invocations"] = aws.Int64Value(v.MaxInvocations)
+			tfMap["model_latency"] = aws.Int64Value(v.ModelLatency)
+		}
+
+		if v := apiObject.ModelConfiguration; v != nil {
+			tfMap["inference_specification_name"] = aws.StringValue(v.InferenceSpecificationName)
+
+			environmentParameters := map[string]interface{}{}
+
+			for _, p := range v.EnvironmentParameters {
+				if p == nil {
+					continue
+				}
+
+				environmentParameters[aws.StringValue(p.Key)] = aws.StringValue(p.Value)
+			}
+
+			tfMap["environment_parameters"] = environmentParameters
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
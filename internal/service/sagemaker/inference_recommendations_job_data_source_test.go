@@ -0,0 +1,65 @@
+package sagemaker_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSageMakerInferenceRecommendationsJobDataSource_basic(t *testing.T) {
+	jobName := os.Getenv("SAGEMAKER_INFERENCE_RECOMMENDATIONS_JOB_NAME")
+	if jobName == "" {
+		t.Skip("Environment variable SAGEMAKER_INFERENCE_RECOMMENDATIONS_JOB_NAME is not set")
+	}
+
+	dataSourceName := "data.aws_sagemaker_inference_recommendations_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInferenceRecommendationsJobDataSourceConfig(jobName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "job_name", jobName),
+					acctest.CheckResourceAttrRegionalARN(dataSourceName, "arn", "sagemaker", fmt.Sprintf("inference-recommendations-job/%s", jobName)),
+					resource.TestCheckResourceAttr(dataSourceName, "status", sagemaker.RecommendationJobStatusCompleted),
+					resource.TestCheckResourceAttrSet(dataSourceName, "model_package_version_arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "inference_recommendations.0.instance_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "inference_recommendations.0.cost_per_hour"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSageMakerInferenceRecommendationsJobDataSource_nonExistent(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInferenceRecommendationsJobDataSourceConfig(rName),
+				ExpectError: regexp.MustCompile(`error reading SageMaker Inference Recommendations Job`),
+			},
+		},
+	})
+}
+
+func testAccInferenceRecommendationsJobDataSourceConfig(jobName string) string {
+	return fmt.Sprintf(`
+data "aws_sagemaker_inference_recommendations_job" "test" {
+  job_name = %[1]q
+}
+`, jobName)
+}
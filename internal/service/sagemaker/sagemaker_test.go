@@ -33,6 +33,7 @@ func TestAccSageMaker_serial(t *testing.T) {
 		},
 		"Domain": {
 			"basic":                                    testAccDomain_basic,
+			"domainSettings":                           testAccDomain_domainSettings,
 			"disappears":                               testAccDomain_tags,
 			"tags":                                     testAccDomain_disappears,
 			"tensorboardAppSettings":                   testAccDomain_tensorboardAppSettings,
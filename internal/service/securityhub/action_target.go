@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceActionTarget() *schema.Resource {
@@ -96,18 +97,18 @@ func resourceActionTargetRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	actionTarget, err := ActionTargetCheckExists(conn, d.Id())
+	actionTarget, err := FindActionTargetByARN(conn, d.Id())
 
-	if err != nil {
-		return fmt.Errorf("Error reading Security Hub custom action targets to find %s: %s", d.Id(), err)
-	}
-
-	if actionTarget == nil {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Hub custom action target (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub custom action target (%s): %w", d.Id(), err)
+	}
+
 	d.Set("identifier", actionTargetIdentifier)
 	d.Set("description", actionTarget.Description)
 	d.Set("arn", actionTarget.ActionTargetArn)
@@ -130,28 +131,6 @@ func resourceActionTargetUpdate(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func ActionTargetCheckExists(conn *securityhub.SecurityHub, actionTargetArn string) (*securityhub.ActionTarget, error) {
-	input := &securityhub.DescribeActionTargetsInput{
-		ActionTargetArns: aws.StringSlice([]string{actionTargetArn}),
-	}
-	var found *securityhub.ActionTarget = nil
-	err := conn.DescribeActionTargetsPages(input, func(page *securityhub.DescribeActionTargetsOutput, lastPage bool) bool {
-		for _, actionTarget := range page.ActionTargets {
-			if aws.StringValue(actionTarget.ActionTargetArn) == actionTargetArn {
-				found = actionTarget
-				return false
-			}
-		}
-		return !lastPage
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	return found, nil
-}
-
 func resourceActionTargetDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SecurityHubConn
 	log.Printf("[DEBUG] Deleting Security Hub custom action target %s", d.Id())
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccActionTarget_basic(t *testing.T) {
@@ -139,17 +140,9 @@ func testAccCheckActionTargetExists(n string) resource.TestCheckFunc {
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
 
-		action, err := tfsecurityhub.ActionTargetCheckExists(conn, rs.Primary.ID)
+		_, err := tfsecurityhub.FindActionTargetByARN(conn, rs.Primary.ID)
 
-		if err != nil {
-			return err
-		}
-
-		if action == nil {
-			return fmt.Errorf("Security Hub custom action %s not found", rs.Primary.ID)
-		}
-
-		return nil
+		return err
 	}
 }
 
@@ -161,7 +154,11 @@ func testAccCheckActionTargetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		action, err := tfsecurityhub.ActionTargetCheckExists(conn, rs.Primary.ID)
+		_, err := tfsecurityhub.FindActionTargetByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
 
 		if tfawserr.ErrMessageContains(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
 			continue
@@ -171,9 +168,7 @@ func testAccCheckActionTargetDestroy(s *terraform.State) error {
 			return err
 		}
 
-		if action != nil {
-			return fmt.Errorf("Security Hub custom action %s still exists", rs.Primary.ID)
-		}
+		return fmt.Errorf("Security Hub custom action %s still exists", rs.Primary.ID)
 	}
 
 	return nil
@@ -7,9 +7,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func FindAdminAccount(conn *securityhub.SecurityHub, adminAccountID string) (*securityhub.AdminAccount, error) {
+func FindAdminAccountByID(conn *securityhub.SecurityHub, adminAccountID string) (*securityhub.AdminAccount, error) {
 	input := &securityhub.ListOrganizationAdminAccountsInput{}
 	var result *securityhub.AdminAccount
 
@@ -32,26 +33,103 @@ func FindAdminAccount(conn *securityhub.SecurityHub, adminAccountID string) (*se
 		return !lastPage
 	})
 
-	return result, err
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
 }
 
-func FindInsight(ctx context.Context, conn *securityhub.SecurityHub, arn string) (*securityhub.Insight, error) {
-	input := &securityhub.GetInsightsInput{
-		InsightArns: aws.StringSlice([]string{arn}),
-		MaxResults:  aws.Int64(1),
+func FindFindingAggregatorByARN(conn *securityhub.SecurityHub, arn string) (*securityhub.GetFindingAggregatorOutput, error) {
+	input := &securityhub.ListFindingAggregatorsInput{}
+	found := false
+
+	err := conn.ListFindingAggregatorsPages(input, func(page *securityhub.ListFindingAggregatorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, aggregator := range page.FindingAggregators {
+			if aws.StringValue(aggregator.FindingAggregatorArn) == arn {
+				found = true
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	getInput := &securityhub.GetFindingAggregatorInput{
+		FindingAggregatorArn: aws.String(arn),
 	}
 
-	output, err := conn.GetInsightsWithContext(ctx, input)
+	output, err := conn.GetFindingAggregator(getInput)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: getInput,
+		}
+	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	if output == nil || len(output.Insights) == 0 {
-		return nil, nil
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(getInput)
 	}
 
-	return output.Insights[0], nil
+	return output, nil
+}
+
+func FindProductSubscriptionByARN(conn *securityhub.SecurityHub, arn string) (string, error) {
+	input := &securityhub.ListEnabledProductsForImportInput{}
+	found := false
+
+	err := conn.ListEnabledProductsForImportPages(input, func(page *securityhub.ListEnabledProductsForImportOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, productSubscriptionARN := range page.ProductSubscriptions {
+			if aws.StringValue(productSubscriptionARN) == arn {
+				found = true
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if !found {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return arn, nil
 }
 
 func FindStandardsControlByStandardsSubscriptionARNAndStandardsControlARN(ctx context.Context, conn *securityhub.SecurityHub, standardsSubscriptionARN, standardsControlARN string) (*securityhub.StandardsControl, error) {
@@ -111,6 +189,10 @@ func FindStandardsSubscriptionByARN(conn *securityhub.SecurityHub, arn string) (
 		}
 	}
 
+	if err != nil {
+		return nil, err
+	}
+
 	if output == nil || len(output.StandardsSubscriptions) == 0 || output.StandardsSubscriptions[0] == nil {
 		return nil, &resource.NotFoundError{
 			Message:     "Empty result",
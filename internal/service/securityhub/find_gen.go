@@ -0,0 +1,118 @@
+// Code generated by "internal/generate/finders/main.go -NotFoundErrCode=ErrCodeResourceNotFoundException -Finder=Name=ActionTarget,By=ARN,Op=DescribeActionTargets,InputField=ActionTargetArns,OutputField=ActionTargets,MatchField=ActionTargetArn -Finder=Name=Insight,By=ARN,Op=GetInsights,InputField=InsightArns,OutputField=Insights,MatchField=InsightArn,Context=true -Finder=Name=Member,By=AccountID,Op=GetMembers,InputField=AccountIds,OutputField=Members,MatchField=AccountId"; DO NOT EDIT.
+
+package securityhub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindActionTargetByARN(conn *securityhub.SecurityHub, arn string) (*securityhub.ActionTarget, error) {
+	input := &securityhub.DescribeActionTargetsInput{
+		ActionTargetArns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.DescribeActionTargets(input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ActionTargets) == 0 || output.ActionTargets[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ActionTargets); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	if v := output.ActionTargets[0]; aws.StringValue(v.ActionTargetArn) != arn {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output.ActionTargets[0], nil
+}
+
+func FindInsightByARN(ctx context.Context, conn *securityhub.SecurityHub, arn string) (*securityhub.Insight, error) {
+	input := &securityhub.GetInsightsInput{
+		InsightArns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.GetInsightsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Insights) == 0 || output.Insights[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Insights); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	if v := output.Insights[0]; aws.StringValue(v.InsightArn) != arn {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output.Insights[0], nil
+}
+
+func FindMemberByAccountID(conn *securityhub.SecurityHub, accountID string) (*securityhub.Member, error) {
+	input := &securityhub.GetMembersInput{
+		AccountIds: aws.StringSlice([]string{accountID}),
+	}
+
+	output, err := conn.GetMembers(input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Members) == 0 || output.Members[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Members); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	if v := output.Members[0]; aws.StringValue(v.AccountId) != accountID {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output.Members[0], nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -81,20 +82,18 @@ func resourceFindingAggregatorRead(d *schema.ResourceData, meta interface{}) err
 
 	aggregatorArn := d.Id()
 
-	log.Printf("[DEBUG] Reading Security Hub finding aggregator to find %s", aggregatorArn)
+	aggregator, err := FindFindingAggregatorByARN(conn, aggregatorArn)
 
-	aggregator, err := FindingAggregatorCheckExists(conn, aggregatorArn)
-
-	if err != nil {
-		return fmt.Errorf("Error reading Security Hub finding aggregator to find %s: %s", aggregatorArn, err)
-	}
-
-	if aggregator == nil {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Hub finding aggregator (%s) not found, removing from state", aggregatorArn)
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub finding aggregator (%s): %w", aggregatorArn, err)
+	}
+
 	d.Set("linking_mode", aggregator.RegionLinkingMode)
 
 	if len(aggregator.Regions) > 0 {
@@ -104,32 +103,6 @@ func resourceFindingAggregatorRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func FindingAggregatorCheckExists(conn *securityhub.SecurityHub, findingAggregatorArn string) (*securityhub.GetFindingAggregatorOutput, error) {
-	input := &securityhub.ListFindingAggregatorsInput{}
-
-	var found *securityhub.GetFindingAggregatorOutput
-	var err error = nil
-
-	err = conn.ListFindingAggregatorsPages(input, func(page *securityhub.ListFindingAggregatorsOutput, lastPage bool) bool {
-		for _, aggregator := range page.FindingAggregators {
-			if aws.StringValue(aggregator.FindingAggregatorArn) == findingAggregatorArn {
-				getInput := &securityhub.GetFindingAggregatorInput{
-					FindingAggregatorArn: &findingAggregatorArn,
-				}
-				found, err = conn.GetFindingAggregator(getInput)
-				return false
-			}
-		}
-		return !lastPage
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	return found, nil
-}
-
 func resourceFindingAggregatorUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SecurityHubConn
 
@@ -1,4 +1,5 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/finders/main.go -NotFoundErrCode=ErrCodeResourceNotFoundException -Finder=Name=ActionTarget,By=ARN,Op=DescribeActionTargets,InputField=ActionTargetArns,OutputField=ActionTargets,MatchField=ActionTargetArn -Finder=Name=Insight,By=ARN,Op=GetInsights,InputField=InsightArns,OutputField=Insights,MatchField=InsightArn,Context=true -Finder=Name=Member,By=AccountID,Op=GetMembers,InputField=AccountIds,OutputField=Members,MatchField=AccountId
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package securityhub
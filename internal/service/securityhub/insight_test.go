@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccInsight_basic(t *testing.T) {
@@ -444,21 +445,21 @@ func testAccCheckInsightDestroy(s *terraform.State) error {
 			continue
 		}
 
-		insight, err := tfsecurityhub.FindInsight(context.Background(), conn, rs.Primary.ID)
+		_, err := tfsecurityhub.FindInsightByARN(context.Background(), conn, rs.Primary.ID)
 
-		if err != nil {
-			if tfawserr.ErrMessageContains(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
-				continue
-			}
-			if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
-				continue
-			}
-			return fmt.Errorf("error deleting Security Hub Insight (%s): %w", rs.Primary.ID, err)
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if tfawserr.ErrMessageContains(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			continue
 		}
 
-		if insight != nil {
-			return fmt.Errorf("Security Hub Insight (%s) still exists", rs.Primary.ID)
+		if err != nil {
+			return err
 		}
+
+		return fmt.Errorf("Security Hub Insight (%s) still exists", rs.Primary.ID)
 	}
 
 	return nil
@@ -473,17 +474,9 @@ func testAccCheckInsightExists(n string) resource.TestCheckFunc {
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
 
-		insight, err := tfsecurityhub.FindInsight(context.Background(), conn, rs.Primary.ID)
-
-		if err != nil {
-			return fmt.Errorf("error reading Security Hub Insight (%s): %w", rs.Primary.ID, err)
-		}
-
-		if insight == nil {
-			return fmt.Errorf("error reading Security Hub Insight (%s): not found", rs.Primary.ID)
-		}
+		_, err := tfsecurityhub.FindInsightByARN(context.Background(), conn, rs.Primary.ID)
 
-		return nil
+		return err
 	}
 }
 
@@ -21,16 +21,27 @@ func ResourceInviteAccepter() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"master_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+			"administrator_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"administrator_id", "master_id"},
 			},
 
 			"invitation_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"master_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Deprecated:   "Use administrator_id instead",
+				ExactlyOneOf: []string{"administrator_id", "master_id"},
+			},
 		},
 	}
 }
@@ -39,15 +50,21 @@ func resourceInviteAccepterCreate(d *schema.ResourceData, meta interface{}) erro
 	conn := meta.(*conns.AWSClient).SecurityHubConn
 	log.Print("[DEBUG] Accepting Security Hub invitation")
 
-	invitationId, err := resourceInviteAccepterGetInvitationID(conn, d.Get("master_id").(string))
+	administratorID := d.Get("administrator_id").(string)
+
+	if v, ok := d.GetOk("master_id"); ok {
+		administratorID = v.(string)
+	}
+
+	invitationId, err := resourceInviteAccepterGetInvitationID(conn, administratorID)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = conn.AcceptInvitation(&securityhub.AcceptInvitationInput{
-		InvitationId: aws.String(invitationId),
-		MasterId:     aws.String(d.Get("master_id").(string)),
+	_, err = conn.AcceptAdministratorInvitation(&securityhub.AcceptAdministratorInvitationInput{
+		AdministratorId: aws.String(administratorID),
+		InvitationId:    aws.String(invitationId),
 	})
 
 	if err != nil {
@@ -59,8 +76,8 @@ func resourceInviteAccepterCreate(d *schema.ResourceData, meta interface{}) erro
 	return resourceInviteAccepterRead(d, meta)
 }
 
-func resourceInviteAccepterGetInvitationID(conn *securityhub.SecurityHub, masterId string) (string, error) {
-	log.Printf("[DEBUG] Getting InvitationId for MasterId %s", masterId)
+func resourceInviteAccepterGetInvitationID(conn *securityhub.SecurityHub, administratorId string) (string, error) {
+	log.Printf("[DEBUG] Getting InvitationId for AdministratorId %s", administratorId)
 
 	resp, err := conn.ListInvitations(&securityhub.ListInvitationsInput{})
 
@@ -70,53 +87,55 @@ func resourceInviteAccepterGetInvitationID(conn *securityhub.SecurityHub, master
 
 	for _, invitation := range resp.Invitations {
 		log.Printf("[DEBUG] Invitation: %s", invitation)
-		if aws.StringValue(invitation.AccountId) == masterId {
+		if aws.StringValue(invitation.AccountId) == administratorId {
 			return *invitation.InvitationId, nil
 		}
 	}
 
-	return "", fmt.Errorf("Cannot find InvitationId for MasterId %s", masterId)
+	return "", fmt.Errorf("Cannot find InvitationId for AdministratorId %s", administratorId)
 }
 
 func resourceInviteAccepterRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SecurityHubConn
-	log.Print("[DEBUG] Reading Security Hub master account")
+	log.Print("[DEBUG] Reading Security Hub administrator account")
 
-	resp, err := conn.GetMasterAccount(&securityhub.GetMasterAccountInput{})
+	resp, err := conn.GetAdministratorAccount(&securityhub.GetAdministratorAccountInput{})
 	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
-		log.Print("[WARN] Security Hub master account not found, removing from state")
+		log.Print("[WARN] Security Hub administrator account not found, removing from state")
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("error retrieving Security Hub master account: %w", err)
+		return fmt.Errorf("error retrieving Security Hub administrator account: %w", err)
 	}
 
-	master := resp.Master
+	administrator := resp.Administrator
 
-	if master == nil {
-		log.Print("[WARN] Security Hub master account not found, removing from state")
+	if administrator == nil {
+		log.Print("[WARN] Security Hub administrator account not found, removing from state")
 		d.SetId("")
 		return nil
 	}
 
-	d.Set("invitation_id", master.InvitationId)
-	d.Set("master_id", master.AccountId)
+	d.Set("administrator_id", administrator.AccountId)
+	d.Set("invitation_id", administrator.InvitationId)
+	d.Set("master_id", administrator.AccountId)
 
 	return nil
 }
 
 func resourceInviteAccepterDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SecurityHubConn
-	log.Print("[DEBUG] Disassociating from Security Hub master account")
+	log.Print("[DEBUG] Disassociating from Security Hub administrator account")
 
-	_, err := conn.DisassociateFromMasterAccount(&securityhub.DisassociateFromMasterAccountInput{})
+	_, err := conn.DisassociateFromAdministratorAccount(&securityhub.DisassociateFromAdministratorAccountInput{})
 
-	if tfawserr.ErrMessageContains(err, "BadRequestException", "The request is rejected because the current account is not associated to a master account") {
+	// The message refers to a master or an administrator account depending on the API version.
+	if tfawserr.ErrMessageContains(err, ErrCodeBadRequestException, "the current account is not associated to") {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("error disassociating from Security Hub master account: %w", err)
+		return fmt.Errorf("error disassociating from Security Hub administrator account: %w", err)
 	}
 
 	return nil
@@ -43,6 +43,35 @@ func testAccInviteAccepter_basic(t *testing.T) {
 	})
 }
 
+func testAccInviteAccepter_masterID(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_securityhub_invite_accepter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInviteAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInviteAccepterConfig_masterID(acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInviteAccepterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "administrator_id", resourceName, "master_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "master_id", "aws_securityhub_member.source", "master_id"),
+				),
+			},
+			{
+				Config:   testAccInviteAccepterConfig_basic(acctest.DefaultEmailAddress),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckInviteAccepterExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[resourceName]
@@ -52,14 +81,14 @@ func testAccCheckInviteAccepterExists(resourceName string) resource.TestCheckFun
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
 
-		resp, err := conn.GetMasterAccount(&securityhub.GetMasterAccountInput{})
+		resp, err := conn.GetAdministratorAccount(&securityhub.GetAdministratorAccountInput{})
 
 		if err != nil {
-			return fmt.Errorf("error retrieving Security Hub master account: %w", err)
+			return fmt.Errorf("error retrieving Security Hub administrator account: %w", err)
 		}
 
-		if resp == nil || resp.Master == nil || aws.StringValue(resp.Master.AccountId) == "" {
-			return fmt.Errorf("Security Hub master account not found for: %s", resourceName)
+		if resp == nil || resp.Administrator == nil || aws.StringValue(resp.Administrator.AccountId) == "" {
+			return fmt.Errorf("Security Hub administrator account not found for: %s", resourceName)
 		}
 
 		return nil
@@ -74,7 +103,7 @@ func testAccCheckInviteAccepterDestroy(s *terraform.State) error {
 			continue
 		}
 
-		resp, err := conn.GetMasterAccount(&securityhub.GetMasterAccountInput{})
+		resp, err := conn.GetAdministratorAccount(&securityhub.GetAdministratorAccountInput{})
 		if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
 			continue
 		}
@@ -83,14 +112,14 @@ func testAccCheckInviteAccepterDestroy(s *terraform.State) error {
 			continue
 		}
 		if err != nil {
-			return fmt.Errorf("error retrieving Security Hub master account: %w", err)
+			return fmt.Errorf("error retrieving Security Hub administrator account: %w", err)
 		}
 
-		if resp == nil || resp.Master == nil || aws.StringValue(resp.Master.AccountId) == "" {
+		if resp == nil || resp.Administrator == nil || aws.StringValue(resp.Administrator.AccountId) == "" {
 			continue
 		}
 
-		return fmt.Errorf("Security Hub master account still configured: %s", aws.StringValue(resp.Master.AccountId))
+		return fmt.Errorf("Security Hub administrator account still configured: %s", aws.StringValue(resp.Administrator.AccountId))
 	}
 	return nil
 }
@@ -98,13 +127,31 @@ func testAccCheckInviteAccepterDestroy(s *terraform.State) error {
 func testAccInviteAccepterConfig_basic(email string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAlternateAccountProvider(),
-		fmt.Sprintf(`
+		`
+resource "aws_securityhub_invite_accepter" "test" {
+  administrator_id = aws_securityhub_member.source.administrator_id
+
+  depends_on = [aws_securityhub_account.test]
+}
+`,
+		testAccInviteAccepterConfigBase(email))
+}
+
+func testAccInviteAccepterConfig_masterID(email string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		`
 resource "aws_securityhub_invite_accepter" "test" {
   master_id = aws_securityhub_member.source.master_id
 
   depends_on = [aws_securityhub_account.test]
 }
+`,
+		testAccInviteAccepterConfigBase(email))
+}
 
+func testAccInviteAccepterConfigBase(email string) string {
+	return fmt.Sprintf(`
 resource "aws_securityhub_member" "source" {
   provider = awsalternate
 
@@ -122,5 +169,5 @@ resource "aws_securityhub_account" "source" {
 }
 
 data "aws_caller_identity" "test" {}
-`, email))
+`, email)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -39,6 +40,10 @@ func ResourceMember() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidAccountID,
 			},
+			"administrator_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"email": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -50,8 +55,9 @@ func ResourceMember() *schema.Resource {
 				ForceNew: true,
 			},
 			"master_id": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "Use administrator_id instead",
 			},
 			"member_status": {
 				Type:     schema.TypeString,
@@ -105,31 +111,22 @@ func resourceMemberCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceMemberRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SecurityHubConn
 
-	log.Printf("[DEBUG] Reading Security Hub member %s", d.Id())
-	resp, err := conn.GetMembers(&securityhub.GetMembersInput{
-		AccountIds: []*string{aws.String(d.Id())},
-	})
+	member, err := FindMemberByAccountID(conn, d.Id())
 
-	if err != nil {
-		if tfawserr.ErrMessageContains(err, securityhub.ErrCodeResourceNotFoundException, "") {
-			log.Printf("[WARN] Security Hub member (%s) not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-		return err
-	}
-
-	if len(resp.Members) == 0 {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Hub member (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
-	member := resp.Members[0]
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub member (%s): %w", d.Id(), err)
+	}
 
 	d.Set("account_id", member.AccountId)
 	d.Set("email", member.Email)
-	d.Set("master_id", member.MasterId)
+	d.Set("administrator_id", member.AdministratorId)
+	d.Set("master_id", member.AdministratorId)
 
 	status := aws.StringValue(member.MemberStatus)
 	d.Set("member_status", status)
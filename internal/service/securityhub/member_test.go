@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -12,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccMember_basic(t *testing.T) {
@@ -75,19 +75,13 @@ func testAccCheckMemberExists(n string, member *securityhub.Member) resource.Tes
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
 
-		resp, err := conn.GetMembers(&securityhub.GetMembersInput{
-			AccountIds: []*string{aws.String(rs.Primary.ID)},
-		})
+		output, err := tfsecurityhub.FindMemberByAccountID(conn, rs.Primary.ID)
 
 		if err != nil {
 			return err
 		}
 
-		if len(resp.Members) == 0 {
-			return fmt.Errorf("Security Hub member %s not found", rs.Primary.ID)
-		}
-
-		member = resp.Members[0]
+		*member = *output
 
 		return nil
 	}
@@ -101,27 +95,21 @@ func testAccCheckMemberDestroy(s *terraform.State) error {
 			continue
 		}
 
-		resp, err := conn.GetMembers(&securityhub.GetMembersInput{
-			AccountIds: []*string{aws.String(rs.Primary.ID)},
-		})
+		_, err := tfsecurityhub.FindMemberByAccountID(conn, rs.Primary.ID)
 
-		if tfawserr.ErrCodeEquals(err, tfsecurityhub.ErrCodeBadRequestException) {
+		if tfresource.NotFound(err) {
 			continue
 		}
 
-		if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		if tfawserr.ErrCodeEquals(err, tfsecurityhub.ErrCodeBadRequestException) {
 			continue
 		}
 
 		if err != nil {
-			return fmt.Errorf("error getting Security Hub Member (%s): %w", rs.Primary.ID, err)
-		}
-
-		if len(resp.Members) != 0 {
-			return fmt.Errorf("Security Hub member still exists")
+			return err
 		}
 
-		return nil
+		return fmt.Errorf("Security Hub Member (%s) still exists", rs.Primary.ID)
 	}
 
 	return nil
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -60,9 +61,9 @@ func resourceOrganizationAdminAccountCreate(d *schema.ResourceData, meta interfa
 func resourceOrganizationAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SecurityHubConn
 
-	adminAccount, err := FindAdminAccount(conn, d.Id())
+	adminAccount, err := FindAdminAccountByID(conn, d.Id())
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Hub Organization Admin Account (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -72,16 +73,6 @@ func resourceOrganizationAdminAccountRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error reading Security Hub Organization Admin Account (%s): %w", d.Id(), err)
 	}
 
-	if adminAccount == nil {
-		if d.IsNewResource() {
-			return fmt.Errorf("error reading Security Hub Organization Admin Account (%s): %w", d.Id(), err)
-		}
-
-		log.Printf("[WARN] Security Hub Organization Admin Account (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
-
 	d.Set("admin_account_id", adminAccount.AccountId)
 
 	return nil
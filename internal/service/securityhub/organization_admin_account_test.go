@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccOrganizationAdminAccount_basic(t *testing.T) {
@@ -103,7 +104,11 @@ func testAccCheckOrganizationAdminAccountDestroy(s *terraform.State) error {
 			continue
 		}
 
-		adminAccount, err := tfsecurityhub.FindAdminAccount(conn, rs.Primary.ID)
+		_, err := tfsecurityhub.FindAdminAccountByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
 
 		// Because of this resource's dependency, the Organizations organization
 		// will be deleted first, resulting in the following valid error
@@ -115,10 +120,6 @@ func testAccCheckOrganizationAdminAccountDestroy(s *terraform.State) error {
 			return err
 		}
 
-		if adminAccount == nil {
-			continue
-		}
-
 		return fmt.Errorf("expected Security Hub Organization Admin Account (%s) to be removed", rs.Primary.ID)
 	}
 
@@ -134,17 +135,9 @@ func testAccCheckOrganizationAdminAccountExists(resourceName string) resource.Te
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
 
-		adminAccount, err := tfsecurityhub.FindAdminAccount(conn, rs.Primary.ID)
-
-		if err != nil {
-			return err
-		}
-
-		if adminAccount == nil {
-			return fmt.Errorf("Security Hub Organization Admin Account (%s) not found", rs.Primary.ID)
-		}
+		_, err := tfsecurityhub.FindAdminAccountByID(conn, rs.Primary.ID)
 
-		return nil
+		return err
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -64,47 +65,24 @@ func resourceProductSubscriptionRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	log.Printf("[DEBUG] Reading Security Hub product subscriptions to find %s", d.Id())
+	_, err = FindProductSubscriptionByARN(conn, productSubscriptionArn)
 
-	exists, err := ProductSubscriptionCheckExists(conn, productSubscriptionArn)
-
-	if err != nil {
-		return fmt.Errorf("Error reading Security Hub product subscriptions to find %s: %s", d.Id(), err)
-	}
-
-	if !exists {
-		log.Printf("[WARN] Security Hub product subscriptions (%s) not found, removing from state", d.Id())
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub product subscription (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub product subscription (%s): %w", d.Id(), err)
+	}
+
 	d.Set("product_arn", productArn)
 	d.Set("arn", productSubscriptionArn)
 
 	return nil
 }
 
-func ProductSubscriptionCheckExists(conn *securityhub.SecurityHub, productSubscriptionArn string) (bool, error) {
-	input := &securityhub.ListEnabledProductsForImportInput{}
-	exists := false
-
-	err := conn.ListEnabledProductsForImportPages(input, func(page *securityhub.ListEnabledProductsForImportOutput, lastPage bool) bool {
-		for _, readProductSubscriptionArn := range page.ProductSubscriptions {
-			if aws.StringValue(readProductSubscriptionArn) == productSubscriptionArn {
-				exists = true
-				return false
-			}
-		}
-		return !lastPage
-	})
-
-	if err != nil {
-		return false, err
-	}
-
-	return exists, nil
-}
-
 func ProductSubscriptionParseID(id string) (string, string, error) {
 	parts := strings.SplitN(id, ",", 2)
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccProductSubscription_basic(t *testing.T) {
@@ -86,17 +87,9 @@ func testAccCheckProductSubscriptionExists(n string) resource.TestCheckFunc {
 			return err
 		}
 
-		exists, err := tfsecurityhub.ProductSubscriptionCheckExists(conn, productSubscriptionArn)
+		_, err = tfsecurityhub.FindProductSubscriptionByARN(conn, productSubscriptionArn)
 
-		if err != nil {
-			return err
-		}
-
-		if !exists {
-			return fmt.Errorf("Security Hub product subscription %s not found", rs.Primary.ID)
-		}
-
-		return nil
+		return err
 	}
 }
 
@@ -114,15 +107,17 @@ func testAccCheckProductSubscriptionDestroy(s *terraform.State) error {
 			return err
 		}
 
-		exists, err := tfsecurityhub.ProductSubscriptionCheckExists(conn, productSubscriptionArn)
+		_, err = tfsecurityhub.FindProductSubscriptionByARN(conn, productSubscriptionArn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
 
 		if err != nil {
 			return err
 		}
 
-		if exists {
-			return fmt.Errorf("Security Hub product subscription %s still exists", rs.Primary.ID)
-		}
+		return fmt.Errorf("Security Hub product subscription %s still exists", rs.Primary.ID)
 	}
 
 	return nil
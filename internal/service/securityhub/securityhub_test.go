@@ -33,7 +33,8 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"WorkflowStatus":   testAccInsight_WorkflowStatus,
 		},
 		"InviteAccepter": {
-			"basic": testAccInviteAccepter_basic,
+			"basic":    testAccInviteAccepter_basic,
+			"masterID": testAccInviteAccepter_masterID,
 		},
 		"OrganizationAdminAccount": {
 			"basic":       testAccOrganizationAdminAccount_basic,
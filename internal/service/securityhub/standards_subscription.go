@@ -74,6 +74,10 @@ func resourceStandardsSubscriptionRead(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Standards Subscription (%s): %w", d.Id(), err)
+	}
+
 	d.Set("standards_arn", output.StandardsArn)
 
 	return nil
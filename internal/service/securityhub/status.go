@@ -20,14 +20,15 @@ const (
 // statusAdminAccountAdmin fetches the AdminAccount and its AdminStatus
 func statusAdminAccountAdmin(conn *securityhub.SecurityHub, adminAccountID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		adminAccount, err := FindAdminAccount(conn, adminAccountID)
+		adminAccount, err := FindAdminAccountByID(conn, adminAccountID)
 
-		if err != nil {
-			return nil, adminStatusUnknown, err
+		if tfresource.NotFound(err) {
+			// Return a fake result so that NotFound can be used as both a Pending and Target status.
+			return "", adminStatusNotFound, nil
 		}
 
-		if adminAccount == nil {
-			return adminAccount, adminStatusNotFound, nil
+		if err != nil {
+			return nil, adminStatusUnknown, err
 		}
 
 		return adminAccount, aws.StringValue(adminAccount.Status), nil
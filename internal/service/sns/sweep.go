@@ -46,6 +46,8 @@ func init() {
 			"aws_sns_platform_application",
 		},
 	})
+
+	sweep.RegisterNamePrefixSweepResource("sns", ResourceTopic(), sweep.ARNResourceID)
 }
 
 func sweepPlatformApplications(region string) error {
@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -29,6 +30,23 @@ func init() {
 			"aws_sns_topic",
 		},
 	})
+
+	sweep.RegisterNamePrefixSweepResource("sqs", ResourceQueue(), queueURLFromARN)
+}
+
+func queueURLFromARN(client interface{}, arn arn.ARN) (string, error) {
+	conn := client.(*conns.AWSClient).SQSConn
+
+	output, err := conn.GetQueueUrl(&sqs.GetQueueUrlInput{
+		QueueName:              aws.String(arn.Resource),
+		QueueOwnerAWSAccountId: aws.String(arn.AccountID),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.QueueUrl), nil
 }
 
 func sweepQueues(region string) error {
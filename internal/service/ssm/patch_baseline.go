@@ -1,6 +1,7 @@
 package ssm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -214,10 +216,33 @@ func ResourcePatchBaseline() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourcePatchBaselineCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourcePatchBaselineCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("source"); ok && len(v.([]interface{})) > 0 && diff.Get("operating_system").(string) == ssm.OperatingSystemWindows {
+		return fmt.Errorf("source is not supported for operating_system %q", ssm.OperatingSystemWindows)
+	}
+
+	for i, rule := range diff.Get("approval_rule").([]interface{}) {
+		tfMap, ok := rule.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if tfMap["approve_until_date"].(string) != "" && tfMap["approve_after_days"].(int) != 0 {
+			return fmt.Errorf("approval_rule.%d: only one of approve_after_days or approve_until_date can be specified", i)
+		}
+	}
+
+	return nil
+}
+
 func resourcePatchBaselineCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SSMConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
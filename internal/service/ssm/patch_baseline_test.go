@@ -279,6 +279,27 @@ func TestAccSSMPatchBaseline_sources(t *testing.T) {
 	})
 }
 
+func TestAccSSMPatchBaseline_validation(t *testing.T) {
+	name := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPatchBaselineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatchBaselineApproveAfterDaysAndUntilDateConfig(name),
+				ExpectError: regexp.MustCompile(`only one of approve_after_days or approve_until_date can be specified`),
+			},
+			{
+				Config:      testAccPatchBaselineWindowsWithSourceConfig(name),
+				ExpectError: regexp.MustCompile(`source is not supported for operating_system "WINDOWS"`),
+			},
+		},
+	})
+}
+
 func TestAccSSMPatchBaseline_approvedPatchesNonSec(t *testing.T) {
 	var ssmPatch ssm.PatchBaselineIdentity
 	name := sdkacctest.RandString(10)
@@ -648,3 +669,38 @@ resource "aws_ssm_patch_baseline" "test" {
 }
 `, rName)
 }
+
+func testAccPatchBaselineApproveAfterDaysAndUntilDateConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  operating_system = "AMAZON_LINUX"
+
+  approval_rule {
+    approve_after_days = 7
+    approve_until_date = "2020-01-01"
+
+    patch_filter {
+      key    = "SEVERITY"
+      values = ["Critical", "Important"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccPatchBaselineWindowsWithSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  operating_system = "WINDOWS"
+  approved_patches = ["KB123456"]
+
+  source {
+    name          = "My-Source"
+    configuration = "[main]"
+    products      = ["WindowsServer2019"]
+  }
+}
+`, rName)
+}
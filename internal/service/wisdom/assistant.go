@@ -0,0 +1,218 @@
+package wisdom
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAssistant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAssistantCreate,
+		Read:   resourceAssistantRead,
+		Update: resourceAssistantUpdate,
+		Delete: resourceAssistantDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      connectwisdomservice.AssistantTypeAgent,
+				ValidateFunc: validation.StringInSlice(connectwisdomservice.AssistantType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAssistantCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WisdomConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &connectwisdomservice.CreateAssistantInput{
+		Name: aws.String(name),
+		Type: aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption_configuration"); ok {
+		input.ServerSideEncryptionConfiguration = expandServerSideEncryptionConfiguration(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Wisdom Assistant: %s", input)
+	output, err := conn.CreateAssistant(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Wisdom Assistant (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Assistant.AssistantId))
+
+	if _, err := waitAssistantCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Wisdom Assistant (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceAssistantRead(d, meta)
+}
+
+func resourceAssistantRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WisdomConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	assistant, err := FindAssistantByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Wisdom Assistant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Wisdom Assistant (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", assistant.AssistantArn)
+	d.Set("description", assistant.Description)
+	d.Set("name", assistant.Name)
+	if err := d.Set("server_side_encryption_configuration", flattenServerSideEncryptionConfiguration(assistant.ServerSideEncryptionConfiguration)); err != nil {
+		return fmt.Errorf("error setting server_side_encryption_configuration: %w", err)
+	}
+	d.Set("type", assistant.Type)
+
+	tags := KeyValueTags(assistant.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAssistantUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WisdomConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Wisdom Assistant (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAssistantRead(d, meta)
+}
+
+func resourceAssistantDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WisdomConn
+
+	log.Printf("[DEBUG] Deleting Wisdom Assistant: %s", d.Id())
+	_, err := conn.DeleteAssistant(&connectwisdomservice.DeleteAssistantInput{
+		AssistantId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Wisdom Assistant (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitAssistantDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Wisdom Assistant (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServerSideEncryptionConfiguration(tfList []interface{}) *connectwisdomservice.ServerSideEncryptionConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &connectwisdomservice.ServerSideEncryptionConfiguration{}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenServerSideEncryptionConfiguration(apiObject *connectwisdomservice.ServerSideEncryptionConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"kms_key_id": aws.StringValue(apiObject.KmsKeyId),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,242 @@
+package wisdom
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAssistantAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAssistantAssociationCreate,
+		Read:   resourceAssistantAssociationRead,
+		Update: resourceAssistantAssociationUpdate,
+		Delete: resourceAssistantAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assistant_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assistant_association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assistant_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"association": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"knowledge_base_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"knowledge_base_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"association_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      connectwisdomservice.AssociationTypeKnowledgeBase,
+				ValidateFunc: validation.StringInSlice(connectwisdomservice.AssociationType_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAssistantAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WisdomConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	assistantID := d.Get("assistant_id").(string)
+	input := &connectwisdomservice.CreateAssistantAssociationInput{
+		AssistantId:     aws.String(assistantID),
+		Association:     expandAssistantAssociationInputData(d.Get("association").([]interface{})),
+		AssociationType: aws.String(d.Get("association_type").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Wisdom Assistant Association: %s", input)
+	output, err := conn.CreateAssistantAssociation(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Wisdom Assistant (%s) Association: %w", assistantID, err)
+	}
+
+	d.SetId(AssistantAssociationCreateResourceID(assistantID, aws.StringValue(output.AssistantAssociation.AssistantAssociationId)))
+
+	return resourceAssistantAssociationRead(d, meta)
+}
+
+func resourceAssistantAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WisdomConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	assistantID, associationID, err := AssistantAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	association, err := FindAssistantAssociationByTwoPartKey(conn, assistantID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Wisdom Assistant Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Wisdom Assistant Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", association.AssistantAssociationArn)
+	d.Set("assistant_arn", association.AssistantArn)
+	d.Set("assistant_association_id", association.AssistantAssociationId)
+	d.Set("assistant_id", association.AssistantId)
+	if err := d.Set("association", flattenAssistantAssociationOutputData(association.AssociationData)); err != nil {
+		return fmt.Errorf("error setting association: %w", err)
+	}
+	d.Set("association_type", association.AssociationType)
+
+	tags := KeyValueTags(association.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAssistantAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WisdomConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Wisdom Assistant Association (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAssistantAssociationRead(d, meta)
+}
+
+func resourceAssistantAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WisdomConn
+
+	assistantID, associationID, err := AssistantAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Wisdom Assistant Association: %s", d.Id())
+	_, err = conn.DeleteAssistantAssociation(&connectwisdomservice.DeleteAssistantAssociationInput{
+		AssistantAssociationId: aws.String(associationID),
+		AssistantId:            aws.String(assistantID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Wisdom Assistant Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const assistantAssociationResourceIDSeparator = ","
+
+func AssistantAssociationCreateResourceID(assistantID, associationID string) string {
+	parts := []string{assistantID, associationID}
+	id := strings.Join(parts, assistantAssociationResourceIDSeparator)
+
+	return id
+}
+
+func AssistantAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, assistantAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected assistant-id%[2]sassociation-id", id, assistantAssociationResourceIDSeparator)
+}
+
+func expandAssistantAssociationInputData(tfList []interface{}) *connectwisdomservice.AssistantAssociationInputData {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &connectwisdomservice.AssistantAssociationInputData{}
+
+	if v, ok := tfMap["knowledge_base_id"].(string); ok && v != "" {
+		apiObject.KnowledgeBaseId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAssistantAssociationOutputData(apiObject *connectwisdomservice.AssistantAssociationOutputData) []interface{} {
+	if apiObject == nil || apiObject.KnowledgeBaseAssociation == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"knowledge_base_arn": aws.StringValue(apiObject.KnowledgeBaseAssociation.KnowledgeBaseArn),
+		"knowledge_base_id":  aws.StringValue(apiObject.KnowledgeBaseAssociation.KnowledgeBaseId),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,146 @@
+package wisdom_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwisdom "github.com/hashicorp/terraform-provider-aws/internal/service/wisdom"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWisdomAssistantAssociation_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wisdom_assistant_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(connectwisdomservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssistantAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssistantAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssistantAssociationExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "assistant_arn", "aws_wisdom_assistant.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "assistant_association_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "assistant_id", "aws_wisdom_assistant.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "association.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "association.0.knowledge_base_arn", "aws_wisdom_knowledge_base.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "association.0.knowledge_base_id", "aws_wisdom_knowledge_base.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "association_type", "KNOWLEDGE_BASE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWisdomAssistantAssociation_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wisdom_assistant_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(connectwisdomservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssistantAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssistantAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssistantAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfwisdom.ResourceAssistantAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssistantAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Wisdom Assistant Association ID is set")
+		}
+
+		assistantID, associationID, err := tfwisdom.AssistantAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WisdomConn
+
+		_, err = tfwisdom.FindAssistantAssociationByTwoPartKey(conn, assistantID, associationID)
+
+		return err
+	}
+}
+
+func testAccCheckAssistantAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WisdomConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wisdom_assistant_association" {
+			continue
+		}
+
+		assistantID, associationID, err := tfwisdom.AssistantAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfwisdom.FindAssistantAssociationByTwoPartKey(conn, assistantID, associationID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Wisdom Assistant Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAssistantAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wisdom_assistant" "test" {
+  name = %[1]q
+}
+
+resource "aws_wisdom_knowledge_base" "test" {
+  name                = %[1]q
+  knowledge_base_type = "CUSTOM"
+}
+
+resource "aws_wisdom_assistant_association" "test" {
+  assistant_id = aws_wisdom_assistant.test.id
+
+  association {
+    knowledge_base_id = aws_wisdom_knowledge_base.test.id
+  }
+}
+`, rName)
+}
@@ -0,0 +1,99 @@
+package wisdom
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAssistantByID(conn *connectwisdomservice.ConnectWisdomService, id string) (*connectwisdomservice.AssistantData, error) {
+	input := &connectwisdomservice.GetAssistantInput{
+		AssistantId: aws.String(id),
+	}
+
+	output, err := conn.GetAssistant(input)
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Assistant == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Assistant.Status); status == connectwisdomservice.AssistantStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Assistant, nil
+}
+
+func FindAssistantAssociationByTwoPartKey(conn *connectwisdomservice.ConnectWisdomService, assistantID, associationID string) (*connectwisdomservice.AssistantAssociationData, error) {
+	input := &connectwisdomservice.GetAssistantAssociationInput{
+		AssistantAssociationId: aws.String(associationID),
+		AssistantId:            aws.String(assistantID),
+	}
+
+	output, err := conn.GetAssistantAssociation(input)
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AssistantAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AssistantAssociation, nil
+}
+
+func FindKnowledgeBaseByID(conn *connectwisdomservice.ConnectWisdomService, id string) (*connectwisdomservice.KnowledgeBaseData, error) {
+	input := &connectwisdomservice.GetKnowledgeBaseInput{
+		KnowledgeBaseId: aws.String(id),
+	}
+
+	output, err := conn.GetKnowledgeBase(input)
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.KnowledgeBase == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.KnowledgeBase.Status); status == connectwisdomservice.KnowledgeBaseStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.KnowledgeBase, nil
+}
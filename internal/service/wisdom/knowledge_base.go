@@ -0,0 +1,367 @@
+package wisdom
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceKnowledgeBase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKnowledgeBaseCreate,
+		Read:   resourceKnowledgeBaseRead,
+		Update: resourceKnowledgeBaseUpdate,
+		Delete: resourceKnowledgeBaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"knowledge_base_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connectwisdomservice.KnowledgeBaseType_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"rendering_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"template_uri": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+					},
+				},
+			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+					},
+				},
+			},
+			"source_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_integrations": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_integration_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_fields": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 4096),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceKnowledgeBaseCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WisdomConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &connectwisdomservice.CreateKnowledgeBaseInput{
+		KnowledgeBaseType: aws.String(d.Get("knowledge_base_type").(string)),
+		Name:              aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("rendering_configuration"); ok {
+		input.RenderingConfiguration = expandRenderingConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption_configuration"); ok {
+		input.ServerSideEncryptionConfiguration = expandServerSideEncryptionConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_configuration"); ok {
+		input.SourceConfiguration = expandSourceConfiguration(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Wisdom Knowledge Base: %s", input)
+	output, err := conn.CreateKnowledgeBase(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Wisdom Knowledge Base (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.KnowledgeBase.KnowledgeBaseId))
+
+	if _, err := waitKnowledgeBaseCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Wisdom Knowledge Base (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceKnowledgeBaseRead(d, meta)
+}
+
+func resourceKnowledgeBaseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WisdomConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	knowledgeBase, err := FindKnowledgeBaseByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Wisdom Knowledge Base (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Wisdom Knowledge Base (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", knowledgeBase.KnowledgeBaseArn)
+	d.Set("description", knowledgeBase.Description)
+	d.Set("knowledge_base_type", knowledgeBase.KnowledgeBaseType)
+	d.Set("name", knowledgeBase.Name)
+	if err := d.Set("rendering_configuration", flattenRenderingConfiguration(knowledgeBase.RenderingConfiguration)); err != nil {
+		return fmt.Errorf("error setting rendering_configuration: %w", err)
+	}
+	if err := d.Set("server_side_encryption_configuration", flattenServerSideEncryptionConfiguration(knowledgeBase.ServerSideEncryptionConfiguration)); err != nil {
+		return fmt.Errorf("error setting server_side_encryption_configuration: %w", err)
+	}
+	if err := d.Set("source_configuration", flattenSourceConfiguration(knowledgeBase.SourceConfiguration)); err != nil {
+		return fmt.Errorf("error setting source_configuration: %w", err)
+	}
+
+	tags := KeyValueTags(knowledgeBase.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceKnowledgeBaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WisdomConn
+
+	if d.HasChange("rendering_configuration") {
+		if v := expandRenderingConfiguration(d.Get("rendering_configuration").([]interface{})); v != nil && v.TemplateUri != nil {
+			input := &connectwisdomservice.UpdateKnowledgeBaseTemplateUriInput{
+				KnowledgeBaseId: aws.String(d.Id()),
+				TemplateUri:     v.TemplateUri,
+			}
+
+			log.Printf("[DEBUG] Updating Wisdom Knowledge Base template URI: %s", input)
+			if _, err := conn.UpdateKnowledgeBaseTemplateUri(input); err != nil {
+				return fmt.Errorf("error updating Wisdom Knowledge Base (%s) template URI: %w", d.Id(), err)
+			}
+		} else {
+			input := &connectwisdomservice.RemoveKnowledgeBaseTemplateUriInput{
+				KnowledgeBaseId: aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Removing Wisdom Knowledge Base template URI: %s", input)
+			if _, err := conn.RemoveKnowledgeBaseTemplateUri(input); err != nil {
+				return fmt.Errorf("error removing Wisdom Knowledge Base (%s) template URI: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Wisdom Knowledge Base (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceKnowledgeBaseRead(d, meta)
+}
+
+func resourceKnowledgeBaseDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WisdomConn
+
+	log.Printf("[DEBUG] Deleting Wisdom Knowledge Base: %s", d.Id())
+	_, err := conn.DeleteKnowledgeBase(&connectwisdomservice.DeleteKnowledgeBaseInput{
+		KnowledgeBaseId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Wisdom Knowledge Base (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitKnowledgeBaseDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Wisdom Knowledge Base (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRenderingConfiguration(tfList []interface{}) *connectwisdomservice.RenderingConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &connectwisdomservice.RenderingConfiguration{}
+
+	if v, ok := tfMap["template_uri"].(string); ok && v != "" {
+		apiObject.TemplateUri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSourceConfiguration(tfList []interface{}) *connectwisdomservice.SourceConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &connectwisdomservice.SourceConfiguration{}
+
+	if v, ok := tfMap["app_integrations"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AppIntegrations = expandAppIntegrationsConfiguration(v)
+	}
+
+	return apiObject
+}
+
+func expandAppIntegrationsConfiguration(tfList []interface{}) *connectwisdomservice.AppIntegrationsConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &connectwisdomservice.AppIntegrationsConfiguration{}
+
+	if v, ok := tfMap["app_integration_arn"].(string); ok && v != "" {
+		apiObject.AppIntegrationArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_fields"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ObjectFields = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenRenderingConfiguration(apiObject *connectwisdomservice.RenderingConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"template_uri": aws.StringValue(apiObject.TemplateUri),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSourceConfiguration(apiObject *connectwisdomservice.SourceConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"app_integrations": flattenAppIntegrationsConfiguration(apiObject.AppIntegrations),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAppIntegrationsConfiguration(apiObject *connectwisdomservice.AppIntegrationsConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"app_integration_arn": aws.StringValue(apiObject.AppIntegrationArn),
+		"object_fields":       aws.StringValueSlice(apiObject.ObjectFields),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,184 @@
+package wisdom_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwisdom "github.com/hashicorp/terraform-provider-aws/internal/service/wisdom"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWisdomKnowledgeBase_basic(t *testing.T) {
+	var v connectwisdomservice.KnowledgeBaseData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wisdom_knowledge_base.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(connectwisdomservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKnowledgeBaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rendering_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWisdomKnowledgeBase_disappears(t *testing.T) {
+	var v connectwisdomservice.KnowledgeBaseData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wisdom_knowledge_base.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(connectwisdomservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKnowledgeBaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfwisdom.ResourceKnowledgeBase(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWisdomKnowledgeBase_renderingConfiguration(t *testing.T) {
+	var v connectwisdomservice.KnowledgeBaseData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wisdom_knowledge_base.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(connectwisdomservice.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKnowledgeBaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKnowledgeBaseRenderingConfigurationConfig(rName, "https://example.com/{{Id}}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rendering_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rendering_configuration.0.template_uri", "https://example.com/{{Id}}"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKnowledgeBaseRenderingConfigurationConfig(rName, "https://example.org/{{Id}}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rendering_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rendering_configuration.0.template_uri", "https://example.org/{{Id}}"),
+				),
+			},
+			{
+				Config: testAccKnowledgeBaseConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKnowledgeBaseExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rendering_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKnowledgeBaseExists(n string, v *connectwisdomservice.KnowledgeBaseData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Wisdom Knowledge Base ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WisdomConn
+
+		output, err := tfwisdom.FindKnowledgeBaseByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckKnowledgeBaseDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WisdomConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wisdom_knowledge_base" {
+			continue
+		}
+
+		_, err := tfwisdom.FindKnowledgeBaseByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Wisdom Knowledge Base %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccKnowledgeBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wisdom_knowledge_base" "test" {
+  name                = %[1]q
+  knowledge_base_type = "CUSTOM"
+}
+`, rName)
+}
+
+func testAccKnowledgeBaseRenderingConfigurationConfig(rName, templateURI string) string {
+	return fmt.Sprintf(`
+resource "aws_wisdom_knowledge_base" "test" {
+  name                = %[1]q
+  knowledge_base_type = "CUSTOM"
+
+  rendering_configuration {
+    template_uri = %[2]q
+  }
+}
+`, rName, templateURI)
+}
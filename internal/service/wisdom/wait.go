@@ -0,0 +1,83 @@
+package wisdom
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	assistantCreatedTimeout     = 5 * time.Minute
+	assistantDeletedTimeout     = 5 * time.Minute
+	knowledgeBaseCreatedTimeout = 5 * time.Minute
+	knowledgeBaseDeletedTimeout = 5 * time.Minute
+)
+
+func waitAssistantCreated(conn *connectwisdomservice.ConnectWisdomService, id string) (*connectwisdomservice.AssistantData, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{connectwisdomservice.AssistantStatusCreateInProgress},
+		Target:  []string{connectwisdomservice.AssistantStatusActive},
+		Refresh: statusAssistant(conn, id),
+		Timeout: assistantCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*connectwisdomservice.AssistantData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssistantDeleted(conn *connectwisdomservice.ConnectWisdomService, id string) (*connectwisdomservice.AssistantData, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{connectwisdomservice.AssistantStatusActive, connectwisdomservice.AssistantStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusAssistant(conn, id),
+		Timeout: assistantDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*connectwisdomservice.AssistantData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKnowledgeBaseCreated(conn *connectwisdomservice.ConnectWisdomService, id string) (*connectwisdomservice.KnowledgeBaseData, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{connectwisdomservice.KnowledgeBaseStatusCreateInProgress},
+		Target:  []string{connectwisdomservice.KnowledgeBaseStatusActive},
+		Refresh: statusKnowledgeBase(conn, id),
+		Timeout: knowledgeBaseCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*connectwisdomservice.KnowledgeBaseData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKnowledgeBaseDeleted(conn *connectwisdomservice.ConnectWisdomService, id string) (*connectwisdomservice.KnowledgeBaseData, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{connectwisdomservice.KnowledgeBaseStatusActive, connectwisdomservice.KnowledgeBaseStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusKnowledgeBase(conn, id),
+		Timeout: knowledgeBaseDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*connectwisdomservice.KnowledgeBaseData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,215 @@
+package workspacesweb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBrowserSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBrowserSettingsCreate,
+		Read:   resourceBrowserSettingsRead,
+		Update: resourceBrowserSettingsUpdate,
+		Delete: resourceBrowserSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"browser_policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"customer_managed_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceBrowserSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	policy, err := structure.NormalizeJsonString(d.Get("browser_policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("browser_policy (%s) is invalid JSON: %w", d.Get("browser_policy").(string), err)
+	}
+
+	input := &workspacesweb.CreateBrowserSettingsInput{
+		BrowserPolicy: aws.String(policy),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("customer_managed_key"); ok {
+		input.CustomerManagedKey = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces Web Browser Settings: %s", input)
+	output, err := conn.CreateBrowserSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces Web Browser Settings: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.BrowserSettingsArn))
+
+	return resourceBrowserSettingsRead(d, meta)
+}
+
+func resourceBrowserSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	browserSettings, err := FindBrowserSettingsByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Browser Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Web Browser Settings (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", browserSettings.BrowserSettingsArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(browserSettings.AssociatedPortalArns))
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("browser_policy").(string), aws.StringValue(browserSettings.BrowserPolicy))
+
+	if err != nil {
+		return fmt.Errorf("while setting policy (%s), encountered: %w", policyToSet, err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", policyToSet, err)
+	}
+
+	d.Set("browser_policy", policyToSet)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces Web Browser Settings (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBrowserSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	if d.HasChange("browser_policy") {
+		policy, err := structure.NormalizeJsonString(d.Get("browser_policy").(string))
+
+		if err != nil {
+			return fmt.Errorf("browser_policy (%s) is invalid JSON: %w", d.Get("browser_policy").(string), err)
+		}
+
+		input := &workspacesweb.UpdateBrowserSettingsInput{
+			BrowserPolicy:      aws.String(policy),
+			BrowserSettingsArn: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating WorkSpaces Web Browser Settings: %s", input)
+		_, err = conn.UpdateBrowserSettings(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating WorkSpaces Web Browser Settings (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating WorkSpaces Web Browser Settings (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceBrowserSettingsRead(d, meta)
+}
+
+func resourceBrowserSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web Browser Settings: %s", d.Id())
+	_, err := conn.DeleteBrowserSettings(&workspacesweb.DeleteBrowserSettingsInput{
+		BrowserSettingsArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WorkSpaces Web Browser Settings (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package workspacesweb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBrowserSettingsAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBrowserSettingsAssociationCreate,
+		Read:   resourceBrowserSettingsAssociationRead,
+		Delete: resourceBrowserSettingsAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"browser_settings_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"portal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceBrowserSettingsAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	browserSettingsARN := d.Get("browser_settings_arn").(string)
+	portalARN := d.Get("portal_arn").(string)
+	id := BrowserSettingsAssociationCreateResourceID(browserSettingsARN, portalARN)
+	input := &workspacesweb.AssociateBrowserSettingsInput{
+		BrowserSettingsArn: aws.String(browserSettingsARN),
+		PortalArn:          aws.String(portalARN),
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces Web Browser Settings Association: %s", input)
+	_, err := conn.AssociateBrowserSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces Web Browser Settings Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceBrowserSettingsAssociationRead(d, meta)
+}
+
+func resourceBrowserSettingsAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	browserSettingsARN, portalARN, err := BrowserSettingsAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	_, err = FindBrowserSettingsAssociationByTwoPartKey(conn, browserSettingsARN, portalARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Browser Settings Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Web Browser Settings Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("browser_settings_arn", browserSettingsARN)
+	d.Set("portal_arn", portalARN)
+
+	return nil
+}
+
+func resourceBrowserSettingsAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	_, portalARN, err := BrowserSettingsAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web Browser Settings Association: %s", d.Id())
+	_, err = conn.DisassociateBrowserSettings(&workspacesweb.DisassociateBrowserSettingsInput{
+		PortalArn: aws.String(portalARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WorkSpaces Web Browser Settings Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const browserSettingsAssociationResourceIDSeparator = ","
+
+func BrowserSettingsAssociationCreateResourceID(browserSettingsARN, portalARN string) string {
+	parts := []string{browserSettingsARN, portalARN}
+	id := strings.Join(parts, browserSettingsAssociationResourceIDSeparator)
+
+	return id
+}
+
+func BrowserSettingsAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, browserSettingsAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected browser-settings-arn%[2]sportal-arn", id, browserSettingsAssociationResourceIDSeparator)
+}
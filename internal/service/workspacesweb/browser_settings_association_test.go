@@ -0,0 +1,128 @@
+package workspacesweb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebBrowserSettingsAssociation_basic(t *testing.T) {
+	resourceName := "aws_workspacesweb_browser_settings_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBrowserSettingsAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "browser_settings_arn", "aws_workspacesweb_browser_settings.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "portal_arn", "aws_workspacesweb_portal.test", "arn"),
+					resource.TestCheckResourceAttrPair("aws_workspacesweb_portal.test", "browser_settings_arn", "aws_workspacesweb_browser_settings.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebBrowserSettingsAssociation_disappears(t *testing.T) {
+	resourceName := "aws_workspacesweb_browser_settings_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBrowserSettingsAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourceBrowserSettingsAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBrowserSettingsAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web Browser Settings Association ID is set")
+		}
+
+		browserSettingsARN, portalARN, err := tfworkspacesweb.BrowserSettingsAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		_, err = tfworkspacesweb.FindBrowserSettingsAssociationByTwoPartKey(conn, browserSettingsARN, portalARN)
+
+		return err
+	}
+}
+
+func testAccCheckBrowserSettingsAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_browser_settings_association" {
+			continue
+		}
+
+		browserSettingsARN, portalARN, err := tfworkspacesweb.BrowserSettingsAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfworkspacesweb.FindBrowserSettingsAssociationByTwoPartKey(conn, browserSettingsARN, portalARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web Browser Settings Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBrowserSettingsAssociationConfig() string {
+	return acctest.ConfigCompose(testAccBrowserSettingsConfig("true"), `
+resource "aws_workspacesweb_portal" "test" {}
+
+resource "aws_workspacesweb_browser_settings_association" "test" {
+  browser_settings_arn = aws_workspacesweb_browser_settings.test.arn
+  portal_arn           = aws_workspacesweb_portal.test.arn
+}
+`)
+}
@@ -0,0 +1,137 @@
+package workspacesweb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebBrowserSettings_basic(t *testing.T) {
+	var v workspacesweb.BrowserSettings
+	resourceName := "aws_workspacesweb_browser_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBrowserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsConfig("true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "browser_policy"),
+					resource.TestCheckResourceAttr(resourceName, "associated_portal_arns.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBrowserSettingsConfig("false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "browser_policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebBrowserSettings_disappears(t *testing.T) {
+	var v workspacesweb.BrowserSettings
+	resourceName := "aws_workspacesweb_browser_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBrowserSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsConfig("true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrowserSettingsExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourceBrowserSettings(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBrowserSettingsExists(n string, v *workspacesweb.BrowserSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web Browser Settings ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		output, err := tfworkspacesweb.FindBrowserSettingsByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBrowserSettingsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_browser_settings" {
+			continue
+		}
+
+		_, err := tfworkspacesweb.FindBrowserSettingsByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web Browser Settings %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccBrowserSettingsConfig(enabled string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_browser_settings" "test" {
+  browser_policy = jsonencode({
+    chromePolicies = {
+      DefaultDownloadDirectory = {
+        value = "/home/as2-streaming-user/MyFiles/TemporaryFiles1"
+      }
+      PasswordManagerEnabled = {
+        value = %[1]s
+      }
+    }
+  })
+}
+`, enabled)
+}
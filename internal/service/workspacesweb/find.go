@@ -0,0 +1,151 @@
+package workspacesweb
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindBrowserSettingsByARN(conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.BrowserSettings, error) {
+	input := &workspacesweb.GetBrowserSettingsInput{
+		BrowserSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetBrowserSettings(input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.BrowserSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.BrowserSettings, nil
+}
+
+func FindNetworkSettingsByARN(conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.NetworkSettings, error) {
+	input := &workspacesweb.GetNetworkSettingsInput{
+		NetworkSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetNetworkSettings(input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.NetworkSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.NetworkSettings, nil
+}
+
+func FindPortalByARN(conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.Portal, error) {
+	input := &workspacesweb.GetPortalInput{
+		PortalArn: aws.String(arn),
+	}
+
+	output, err := conn.GetPortal(input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Portal == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Portal, nil
+}
+
+func FindUserSettingsByARN(conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.UserSettings, error) {
+	input := &workspacesweb.GetUserSettingsInput{
+		UserSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetUserSettings(input)
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UserSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.UserSettings, nil
+}
+
+func FindBrowserSettingsAssociationByTwoPartKey(conn *workspacesweb.WorkSpacesWeb, browserSettingsARN, portalARN string) (*workspacesweb.Portal, error) {
+	portal, err := FindPortalByARN(conn, portalARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(portal.BrowserSettingsArn) != browserSettingsARN {
+		return nil, &resource.NotFoundError{}
+	}
+
+	return portal, nil
+}
+
+func FindNetworkSettingsAssociationByTwoPartKey(conn *workspacesweb.WorkSpacesWeb, networkSettingsARN, portalARN string) (*workspacesweb.Portal, error) {
+	portal, err := FindPortalByARN(conn, portalARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(portal.NetworkSettingsArn) != networkSettingsARN {
+		return nil, &resource.NotFoundError{}
+	}
+
+	return portal, nil
+}
+
+func FindUserSettingsAssociationByTwoPartKey(conn *workspacesweb.WorkSpacesWeb, userSettingsARN, portalARN string) (*workspacesweb.Portal, error) {
+	portal, err := FindPortalByARN(conn, portalARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(portal.UserSettingsArn) != userSettingsARN {
+		return nil, &resource.NotFoundError{}
+	}
+
+	return portal, nil
+}
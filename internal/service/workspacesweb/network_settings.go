@@ -0,0 +1,182 @@
+package workspacesweb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNetworkSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkSettingsCreate,
+		Read:   resourceNetworkSettingsRead,
+		Update: resourceNetworkSettingsUpdate,
+		Delete: resourceNetworkSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 3,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &workspacesweb.CreateNetworkSettingsInput{
+		SecurityGroupIds: flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set)),
+		SubnetIds:        flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		VpcId:            aws.String(d.Get("vpc_id").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces Web Network Settings: %s", input)
+	output, err := conn.CreateNetworkSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces Web Network Settings: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkSettingsArn))
+
+	return resourceNetworkSettingsRead(d, meta)
+}
+
+func resourceNetworkSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	networkSettings, err := FindNetworkSettingsByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Network Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Web Network Settings (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", networkSettings.NetworkSettingsArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(networkSettings.AssociatedPortalArns))
+	d.Set("security_group_ids", aws.StringValueSlice(networkSettings.SecurityGroupIds))
+	d.Set("subnet_ids", aws.StringValueSlice(networkSettings.SubnetIds))
+	d.Set("vpc_id", networkSettings.VpcId)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces Web Network Settings (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	if d.HasChanges("security_group_ids", "subnet_ids", "vpc_id") {
+		input := &workspacesweb.UpdateNetworkSettingsInput{
+			NetworkSettingsArn: aws.String(d.Id()),
+			SecurityGroupIds:   flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set)),
+			SubnetIds:          flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set)),
+			VpcId:              aws.String(d.Get("vpc_id").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating WorkSpaces Web Network Settings: %s", input)
+		_, err := conn.UpdateNetworkSettings(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating WorkSpaces Web Network Settings (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating WorkSpaces Web Network Settings (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkSettingsRead(d, meta)
+}
+
+func resourceNetworkSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web Network Settings: %s", d.Id())
+	_, err := conn.DeleteNetworkSettings(&workspacesweb.DeleteNetworkSettingsInput{
+		NetworkSettingsArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WorkSpaces Web Network Settings (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
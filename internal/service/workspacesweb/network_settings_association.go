@@ -0,0 +1,136 @@
+package workspacesweb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceNetworkSettingsAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkSettingsAssociationCreate,
+		Read:   resourceNetworkSettingsAssociationRead,
+		Delete: resourceNetworkSettingsAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_settings_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"portal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceNetworkSettingsAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	networkSettingsARN := d.Get("network_settings_arn").(string)
+	portalARN := d.Get("portal_arn").(string)
+	id := NetworkSettingsAssociationCreateResourceID(networkSettingsARN, portalARN)
+	input := &workspacesweb.AssociateNetworkSettingsInput{
+		NetworkSettingsArn: aws.String(networkSettingsARN),
+		PortalArn:          aws.String(portalARN),
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces Web Network Settings Association: %s", input)
+	_, err := conn.AssociateNetworkSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces Web Network Settings Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceNetworkSettingsAssociationRead(d, meta)
+}
+
+func resourceNetworkSettingsAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	networkSettingsARN, portalARN, err := NetworkSettingsAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	_, err = FindNetworkSettingsAssociationByTwoPartKey(conn, networkSettingsARN, portalARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Network Settings Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Web Network Settings Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("network_settings_arn", networkSettingsARN)
+	d.Set("portal_arn", portalARN)
+
+	return nil
+}
+
+func resourceNetworkSettingsAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	_, portalARN, err := NetworkSettingsAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web Network Settings Association: %s", d.Id())
+	_, err = conn.DisassociateNetworkSettings(&workspacesweb.DisassociateNetworkSettingsInput{
+		PortalArn: aws.String(portalARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WorkSpaces Web Network Settings Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const networkSettingsAssociationResourceIDSeparator = ","
+
+func NetworkSettingsAssociationCreateResourceID(networkSettingsARN, portalARN string) string {
+	parts := []string{networkSettingsARN, portalARN}
+	id := strings.Join(parts, networkSettingsAssociationResourceIDSeparator)
+
+	return id
+}
+
+func NetworkSettingsAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, networkSettingsAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected network-settings-arn%[2]sportal-arn", id, networkSettingsAssociationResourceIDSeparator)
+}
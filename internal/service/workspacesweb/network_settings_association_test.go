@@ -0,0 +1,131 @@
+package workspacesweb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebNetworkSettingsAssociation_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_network_settings_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkSettingsAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkSettingsAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkSettingsAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "network_settings_arn", "aws_workspacesweb_network_settings.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "portal_arn", "aws_workspacesweb_portal.test", "arn"),
+					resource.TestCheckResourceAttrPair("aws_workspacesweb_portal.test", "network_settings_arn", "aws_workspacesweb_network_settings.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebNetworkSettingsAssociation_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_network_settings_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckNetworkSettingsAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkSettingsAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkSettingsAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourceNetworkSettingsAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkSettingsAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web Network Settings Association ID is set")
+		}
+
+		networkSettingsARN, portalARN, err := tfworkspacesweb.NetworkSettingsAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		_, err = tfworkspacesweb.FindNetworkSettingsAssociationByTwoPartKey(conn, networkSettingsARN, portalARN)
+
+		return err
+	}
+}
+
+func testAccCheckNetworkSettingsAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_network_settings_association" {
+			continue
+		}
+
+		networkSettingsARN, portalARN, err := tfworkspacesweb.NetworkSettingsAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfworkspacesweb.FindNetworkSettingsAssociationByTwoPartKey(conn, networkSettingsARN, portalARN)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web Network Settings Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccNetworkSettingsAssociationConfig(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkSettingsConfig(rName, 1), `
+resource "aws_workspacesweb_portal" "test" {}
+
+resource "aws_workspacesweb_network_settings_association" "test" {
+  network_settings_arn = aws_workspacesweb_network_settings.test.arn
+  portal_arn           = aws_workspacesweb_portal.test.arn
+}
+`)
+}
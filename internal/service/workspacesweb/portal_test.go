@@ -0,0 +1,129 @@
+package workspacesweb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccWorkSpacesWebPortal_basic(t *testing.T) {
+	var v workspacesweb.Portal
+	resourceName := "aws_workspacesweb_portal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPortalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig("test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "test1"),
+					resource.TestCheckResourceAttrSet(resourceName, "portal_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "portal_status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPortalConfig("test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "test2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebPortal_disappears(t *testing.T) {
+	var v workspacesweb.Portal
+	resourceName := "aws_workspacesweb_portal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(workspacesweb.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, workspacesweb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPortalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig("test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortalExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfworkspacesweb.ResourcePortal(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPortalExists(n string, v *workspacesweb.Portal) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Web Portal ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+		output, err := tfworkspacesweb.FindPortalByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPortalDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspacesweb_portal" {
+			continue
+		}
+
+		_, err := tfworkspacesweb.FindPortalByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("WorkSpaces Web Portal %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPortalConfig(displayName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  display_name = %[1]q
+}
+`, displayName)
+}
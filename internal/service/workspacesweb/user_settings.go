@@ -0,0 +1,195 @@
+package workspacesweb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUserSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserSettingsCreate,
+		Read:   resourceUserSettingsRead,
+		Update: resourceUserSettingsUpdate,
+		Delete: resourceUserSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"copy_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"download_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"paste_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"print_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"upload_allowed": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.EnabledType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceUserSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &workspacesweb.CreateUserSettingsInput{
+		CopyAllowed:     aws.String(d.Get("copy_allowed").(string)),
+		DownloadAllowed: aws.String(d.Get("download_allowed").(string)),
+		PasteAllowed:    aws.String(d.Get("paste_allowed").(string)),
+		PrintAllowed:    aws.String(d.Get("print_allowed").(string)),
+		UploadAllowed:   aws.String(d.Get("upload_allowed").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces Web User Settings: %s", input)
+	output, err := conn.CreateUserSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces Web User Settings: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.UserSettingsArn))
+
+	return resourceUserSettingsRead(d, meta)
+}
+
+func resourceUserSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	userSettings, err := FindUserSettingsByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web User Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Web User Settings (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", userSettings.UserSettingsArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(userSettings.AssociatedPortalArns))
+	d.Set("copy_allowed", userSettings.CopyAllowed)
+	d.Set("download_allowed", userSettings.DownloadAllowed)
+	d.Set("paste_allowed", userSettings.PasteAllowed)
+	d.Set("print_allowed", userSettings.PrintAllowed)
+	d.Set("upload_allowed", userSettings.UploadAllowed)
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces Web User Settings (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceUserSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	if d.HasChanges("copy_allowed", "download_allowed", "paste_allowed", "print_allowed", "upload_allowed") {
+		input := &workspacesweb.UpdateUserSettingsInput{
+			CopyAllowed:     aws.String(d.Get("copy_allowed").(string)),
+			DownloadAllowed: aws.String(d.Get("download_allowed").(string)),
+			PasteAllowed:    aws.String(d.Get("paste_allowed").(string)),
+			PrintAllowed:    aws.String(d.Get("print_allowed").(string)),
+			UploadAllowed:   aws.String(d.Get("upload_allowed").(string)),
+			UserSettingsArn: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating WorkSpaces Web User Settings: %s", input)
+		_, err := conn.UpdateUserSettings(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating WorkSpaces Web User Settings (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating WorkSpaces Web User Settings (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceUserSettingsRead(d, meta)
+}
+
+func resourceUserSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web User Settings: %s", d.Id())
+	_, err := conn.DeleteUserSettings(&workspacesweb.DeleteUserSettingsInput{
+		UserSettingsArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WorkSpaces Web User Settings (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
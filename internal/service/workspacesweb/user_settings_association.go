@@ -0,0 +1,136 @@
+package workspacesweb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUserSettingsAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserSettingsAssociationCreate,
+		Read:   resourceUserSettingsAssociationRead,
+		Delete: resourceUserSettingsAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_settings_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"portal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceUserSettingsAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	userSettingsARN := d.Get("user_settings_arn").(string)
+	portalARN := d.Get("portal_arn").(string)
+	id := UserSettingsAssociationCreateResourceID(userSettingsARN, portalARN)
+	input := &workspacesweb.AssociateUserSettingsInput{
+		UserSettingsArn: aws.String(userSettingsARN),
+		PortalArn:       aws.String(portalARN),
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces Web User Settings Association: %s", input)
+	_, err := conn.AssociateUserSettings(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces Web User Settings Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceUserSettingsAssociationRead(d, meta)
+}
+
+func resourceUserSettingsAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	userSettingsARN, portalARN, err := UserSettingsAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	_, err = FindUserSettingsAssociationByTwoPartKey(conn, userSettingsARN, portalARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web User Settings Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Web User Settings Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("user_settings_arn", userSettingsARN)
+	d.Set("portal_arn", portalARN)
+
+	return nil
+}
+
+func resourceUserSettingsAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn
+
+	_, portalARN, err := UserSettingsAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web User Settings Association: %s", d.Id())
+	_, err = conn.DisassociateUserSettings(&workspacesweb.DisassociateUserSettingsInput{
+		PortalArn: aws.String(portalARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WorkSpaces Web User Settings Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const userSettingsAssociationResourceIDSeparator = ","
+
+func UserSettingsAssociationCreateResourceID(userSettingsARN, portalARN string) string {
+	parts := []string{userSettingsARN, portalARN}
+	id := strings.Join(parts, userSettingsAssociationResourceIDSeparator)
+
+	return id
+}
+
+func UserSettingsAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, userSettingsAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected user-settings-arn%[2]sportal-arn", id, userSettingsAssociationResourceIDSeparator)
+}
@@ -0,0 +1,210 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// NamePrefixSweeperName is the name of the sweeper that deletes resources whose names
+// start with ResourcePrefix, across all services that register with RegisterNamePrefixSweepResource.
+// Resources are discovered with the Resource Groups Tagging API, which returns resources
+// that are or have previously been tagged, so leftovers need not carry any particular tag.
+const NamePrefixSweeperName = "aws_name_prefix"
+
+// The Resource Groups Tagging API accepts at most 100 resource type filters per request.
+const namePrefixResourceTypeFiltersMaxLen = 100
+
+// NamePrefixSweepResourceIDFunc returns the Terraform resource ID for the resource identified by an ARN.
+type NamePrefixSweepResourceIDFunc func(client interface{}, arn arn.ARN) (string, error)
+
+type namePrefixSweepResource struct {
+	resource *schema.Resource
+	idFunc   NamePrefixSweepResourceIDFunc
+}
+
+var (
+	namePrefixSweepResources      = make(map[string]namePrefixSweepResource)
+	namePrefixSweepResourcesMutex sync.Mutex
+)
+
+func init() {
+	resource.AddTestSweepers(NamePrefixSweeperName, &resource.Sweeper{
+		Name: NamePrefixSweeperName,
+		F:    sweepNamePrefix,
+	})
+}
+
+// RegisterNamePrefixSweepResource registers a Terraform resource with the name prefix sweeper.
+// The resource type is a Resource Groups Tagging API resource type filter, e.g. "sns" or "logs:log-group".
+// A resource type without a colon only matches ARNs whose resource has no type, e.g. SNS topics.
+func RegisterNamePrefixSweepResource(resourceType string, resource *schema.Resource, idFunc NamePrefixSweepResourceIDFunc) {
+	namePrefixSweepResourcesMutex.Lock()
+	defer namePrefixSweepResourcesMutex.Unlock()
+
+	namePrefixSweepResources[resourceType] = namePrefixSweepResource{
+		resource: resource,
+		idFunc:   idFunc,
+	}
+}
+
+// ARNResourceID is a NamePrefixSweepResourceIDFunc for resources whose ID is their ARN.
+func ARNResourceID(_ interface{}, arn arn.ARN) (string, error) {
+	return arn.String(), nil
+}
+
+// ARNResourceName is a NamePrefixSweepResourceIDFunc for resources whose ID is their name.
+func ARNResourceName(_ interface{}, arn arn.ARN) (string, error) {
+	_, name := arnResourceTypeAndName(arn.Resource)
+
+	return name, nil
+}
+
+// arnResourceTypeAndName splits an ARN's resource into its type and name.
+// The type is the text before the first slash or colon, if any.
+// A trailing wildcard, as in CloudWatch Logs log group ARNs, is removed from the name.
+func arnResourceTypeAndName(resource string) (string, string) {
+	resourceType, name := "", resource
+
+	if i := strings.IndexAny(resource, "/:"); i != -1 {
+		resourceType, name = resource[:i], resource[i+1:]
+	}
+
+	return resourceType, strings.TrimSuffix(name, ":*")
+}
+
+// hasNamePrefix returns whether the last path element of a resource name starts with ResourcePrefix.
+func hasNamePrefix(name string) bool {
+	if i := strings.LastIndex(name, "/"); i != -1 {
+		name = name[i+1:]
+	}
+
+	return strings.HasPrefix(name, ResourcePrefix)
+}
+
+func sweepNamePrefix(region string) error {
+	client, err := SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ResourceGroupsTaggingAPIConn
+
+	namePrefixSweepResourcesMutex.Lock()
+	resourceTypes := make([]string, 0, len(namePrefixSweepResources))
+	for resourceType := range namePrefixSweepResources {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	namePrefixSweepResourcesMutex.Unlock()
+
+	sort.Strings(resourceTypes)
+
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]*SweepResource, 0)
+
+	for len(resourceTypes) > 0 {
+		n := len(resourceTypes)
+
+		if n > namePrefixResourceTypeFiltersMaxLen {
+			n = namePrefixResourceTypeFiltersMaxLen
+		}
+
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceTypeFilters: aws.StringSlice(resourceTypes[:n]),
+		}
+		resourceTypes = resourceTypes[n:]
+
+		err := conn.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, mapping := range page.ResourceTagMappingList {
+				sweepResource, err := namePrefixSweepResourceForARN(client, aws.StringValue(mapping.ResourceARN))
+
+				if err != nil {
+					sweeperErrs = multierror.Append(sweeperErrs, err)
+					continue
+				}
+
+				if sweepResource != nil {
+					sweepResources = append(sweepResources, sweepResource)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if SkipSweepError(err) {
+			log.Printf("[WARN] Skipping name prefix sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing resources (%s): %w", region, err))
+		}
+	}
+
+	if err := SweepOrchestrator(sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping resources with name prefix %s (%s): %w", ResourcePrefix, region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+// namePrefixSweepResourceForARN returns the SweepResource for the resource identified by an ARN,
+// or nil if the resource's name does not start with ResourcePrefix or its type is not registered.
+func namePrefixSweepResourceForARN(client interface{}, v string) (*SweepResource, error) {
+	arn, err := arn.Parse(v)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ARN (%s): %w", v, err)
+	}
+
+	resourceType, name := arnResourceTypeAndName(arn.Resource)
+
+	if !hasNamePrefix(name) {
+		return nil, nil
+	}
+
+	key := arn.Service
+
+	if resourceType != "" {
+		key = fmt.Sprintf("%s:%s", arn.Service, resourceType)
+	}
+
+	namePrefixSweepResourcesMutex.Lock()
+	v2, ok := namePrefixSweepResources[key]
+	namePrefixSweepResourcesMutex.Unlock()
+
+	if !ok {
+		return nil, nil
+	}
+
+	id, err := v2.idFunc(client, arn)
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource ID (%s): %w", v, err)
+	}
+
+	d := v2.resource.Data(nil)
+	d.SetId(id)
+
+	log.Printf("[INFO] Sweeping %s (%s)", key, id)
+
+	return NewSweepResource(v2.resource, d, client), nil
+}
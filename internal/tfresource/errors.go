@@ -2,6 +2,7 @@ package tfresource
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -39,3 +40,29 @@ func SetLastError(err, lastErr error) {
 		}
 	}
 }
+
+// AbandonedError is returned when an operation is given up on after a maximum number of attempts.
+type AbandonedError struct {
+	Attempts  int
+	LastError error
+}
+
+func (e *AbandonedError) Error() string {
+	if e.LastError == nil {
+		return fmt.Sprintf("abandoned after %d attempts", e.Attempts)
+	}
+
+	return fmt.Sprintf("abandoned after %d attempts: %s", e.Attempts, e.LastError)
+}
+
+func (e *AbandonedError) Unwrap() error {
+	return e.LastError
+}
+
+// Abandoned returns true if the error represents an "operation abandoned" condition.
+// Specifically, Abandoned returns true if the error or a wrapped error is of type
+// AbandonedError.
+func Abandoned(err error) bool {
+	var e *AbandonedError
+	return errors.As(err, &e)
+}
@@ -51,6 +51,43 @@ func TestNotFound(t *testing.T) {
 	}
 }
 
+func TestAbandoned(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name: "nil error",
+			Err:  nil,
+		},
+		{
+			Name: "other error",
+			Err:  errors.New("test"),
+		},
+		{
+			Name:     "abandoned error",
+			Err:      &tfresource.AbandonedError{Attempts: 3, LastError: errors.New("test")},
+			Expected: true,
+		},
+		{
+			Name:     "wrapped abandoned error",
+			Err:      fmt.Errorf("test: %w", &tfresource.AbandonedError{Attempts: 3}),
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := tfresource.Abandoned(testCase.Err)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestTimedOut(t *testing.T) {
 	testCases := []struct {
 		Name     string
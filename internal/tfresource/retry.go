@@ -103,6 +103,54 @@ func RetryWhenNewResourceNotFound(timeout time.Duration, f func() (interface{},
 	return RetryWhenNewResourceNotFoundContext(context.Background(), timeout, f, isNewResource)
 }
 
+// RetryWhenAbandonAfterContext retries the function `f` every `pollInterval` when the error it returns satisfies `retryable`.
+// `f` is retried until `timeout` expires or, if `maxAttempts` is greater than zero, until `f` has been called `maxAttempts` times.
+// The fixed interval means that `maxAttempts` attempts take about `maxAttempts` times `pollInterval`.
+// If the maximum number of attempts is reached while the error is still retryable, an *AbandonedError wrapping that error is returned.
+func RetryWhenAbandonAfterContext(ctx context.Context, timeout, pollInterval time.Duration, maxAttempts int, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	var output interface{}
+	var attempts int
+
+	err := RetryConfigContext(ctx, 0, 0, 0, pollInterval, timeout, func() *resource.RetryError {
+		var err error
+
+		attempts++
+		output, err = f()
+		retry, err := retryable(err)
+
+		if retry && maxAttempts > 0 && attempts >= maxAttempts {
+			return resource.NonRetryableError(&AbandonedError{
+				Attempts:  attempts,
+				LastError: err,
+			})
+		}
+
+		if retry {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// RetryWhenAbandonAfter retries the function `f` every `pollInterval` when the error it returns satisfies `retryable`.
+// `f` is retried until `timeout` expires or, if `maxAttempts` is greater than zero, until `f` has been called `maxAttempts` times.
+// The fixed interval means that `maxAttempts` attempts take about `maxAttempts` times `pollInterval`.
+// If the maximum number of attempts is reached while the error is still retryable, an *AbandonedError wrapping that error is returned.
+func RetryWhenAbandonAfter(timeout, pollInterval time.Duration, maxAttempts int, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	return RetryWhenAbandonAfterContext(context.Background(), timeout, pollInterval, maxAttempts, f, retryable)
+}
+
 // RetryConfigContext allows configuration of StateChangeConf's various time arguments.
 // This is especially useful for AWS services that are prone to throttling, such as Route53, where
 // the default durations cause problems. To not use a StateChangeConf argument and revert to the
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -224,6 +225,85 @@ func TestRetryWhenNotFound(t *testing.T) {
 	}
 }
 
+func TestRetryWhenAbandonAfter(t *testing.T) {
+	var retryCount int32
+
+	testCases := []struct {
+		Name            string
+		F               func() (interface{}, error)
+		MaxAttempts     int
+		ExpectError     bool
+		ExpectAbandoned bool
+	}{
+		{
+			Name: "no error",
+			F: func() (interface{}, error) {
+				return nil, nil
+			},
+			MaxAttempts: 2,
+		},
+		{
+			Name: "non-retryable other error",
+			F: func() (interface{}, error) {
+				return nil, errors.New("TestCode")
+			},
+			MaxAttempts: 2,
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error abandoned",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("TestCode1", "TestMessage", nil)
+			},
+			MaxAttempts:     2,
+			ExpectError:     true,
+			ExpectAbandoned: true,
+		},
+		{
+			Name: "retryable AWS error unlimited attempts timeout",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("TestCode1", "TestMessage", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error success",
+			F: func() (interface{}, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return nil, awserr.New("TestCode1", "TestMessage", nil)
+				}
+
+				return nil, nil
+			},
+			MaxAttempts: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			_, err := tfresource.RetryWhenAbandonAfter(5*time.Second, time.Second, testCase.MaxAttempts, testCase.F, func(err error) (bool, error) {
+				if tfawserr.ErrCodeEquals(err, "TestCode1") {
+					return true, err
+				}
+
+				return false, err
+			})
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := tfresource.Abandoned(err), testCase.ExpectAbandoned; got != expected {
+				t.Errorf("got abandoned %t, expected %t", got, expected)
+			}
+		})
+	}
+}
+
 func TestRetryConfigContext_error(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,93 @@
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RenameAttributesStateUpgrader returns a StateUpgrader that upgrades state from
+// the specified schema version by renaming attributes.
+// resource is the resource's schema at that version and renames maps each old
+// attribute path to its new name (see RenameAttributes).
+func RenameAttributesStateUpgrader(version int, resource *schema.Resource, renames map[string]string) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Type:    resource.CoreConfigSchema().ImpliedType(),
+		Upgrade: renameAttributesStateUpgradeFunc(renames),
+		Version: version,
+	}
+}
+
+func renameAttributesStateUpgradeFunc(renames map[string]string) schema.StateUpgradeFunc {
+	return func(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		return RenameAttributes(rawState, renames)
+	}
+}
+
+// RenameAttributes moves the values of renamed attributes in a resource's raw state.
+// renames maps each old attribute path to its new name. Attributes of nested blocks
+// are addressed by joining block and attribute names with ".", e.g. "ec2_inbound_permission.ip_range",
+// and are renamed in every element of the block.
+// Attributes that are not present in rawState are ignored.
+func RenameAttributes(rawState map[string]interface{}, renames map[string]string) (map[string]interface{}, error) {
+	if rawState == nil {
+		rawState = map[string]interface{}{}
+	}
+
+	for from, to := range renames {
+		if err := renameAttribute(rawState, strings.Split(from, "."), to); err != nil {
+			return nil, fmt.Errorf("error renaming attribute (%s) to (%s): %w", from, to, err)
+		}
+	}
+
+	return rawState, nil
+}
+
+func renameAttribute(m map[string]interface{}, path []string, to string) error {
+	name := path[0]
+	v, ok := m[name]
+
+	if !ok {
+		return nil
+	}
+
+	if len(path) == 1 {
+		if existing, ok := m[to]; ok && existing != nil {
+			return fmt.Errorf("attribute (%s) already set", to)
+		}
+
+		delete(m, name)
+		m[to] = v
+
+		return nil
+	}
+
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		for _, e := range v {
+			if e == nil {
+				continue
+			}
+
+			tfMap, ok := e.(map[string]interface{})
+
+			if !ok {
+				return fmt.Errorf("unexpected type (%T) for block (%s) element", e, name)
+			}
+
+			if err := renameAttribute(tfMap, path[1:], to); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		return renameAttribute(v, path[1:], to)
+	default:
+		return fmt.Errorf("unexpected type (%T) for block (%s)", v, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package tfresource_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestRenameAttributes(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		RawState    map[string]interface{}
+		Renames     map[string]string
+		Expected    map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Name:     "nil state",
+			Renames:  map[string]string{"old": "new"},
+			Expected: map[string]interface{}{},
+		},
+		{
+			Name: "top-level attribute",
+			RawState: map[string]interface{}{
+				"id":  "test",
+				"old": "value",
+			},
+			Renames: map[string]string{"old": "new"},
+			Expected: map[string]interface{}{
+				"id":  "test",
+				"new": "value",
+			},
+		},
+		{
+			Name: "missing attribute",
+			RawState: map[string]interface{}{
+				"id": "test",
+			},
+			Renames: map[string]string{"old": "new"},
+			Expected: map[string]interface{}{
+				"id": "test",
+			},
+		},
+		{
+			Name: "nested block attribute",
+			RawState: map[string]interface{}{
+				"id": "test",
+				"block": []interface{}{
+					map[string]interface{}{"old": "value1", "other": 1},
+					map[string]interface{}{"old": "value2", "other": 2},
+				},
+			},
+			Renames: map[string]string{"block.old": "new"},
+			Expected: map[string]interface{}{
+				"id": "test",
+				"block": []interface{}{
+					map[string]interface{}{"new": "value1", "other": 1},
+					map[string]interface{}{"new": "value2", "other": 2},
+				},
+			},
+		},
+		{
+			Name: "empty nested block",
+			RawState: map[string]interface{}{
+				"id":    "test",
+				"block": nil,
+			},
+			Renames: map[string]string{"block.old": "new"},
+			Expected: map[string]interface{}{
+				"id":    "test",
+				"block": nil,
+			},
+		},
+		{
+			Name: "new attribute already set",
+			RawState: map[string]interface{}{
+				"old": "value",
+				"new": "value",
+			},
+			Renames:     map[string]string{"old": "new"},
+			ExpectError: true,
+		},
+		{
+			Name: "not a block",
+			RawState: map[string]interface{}{
+				"block": "value",
+			},
+			Renames:     map[string]string{"block.old": "new"},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := tfresource.RenameAttributes(testCase.RawState, testCase.Renames)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectError {
+				return
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRenameAttributesStateUpgrader(t *testing.T) {
+	v0 := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"old": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+
+	upgrader := tfresource.RenameAttributesStateUpgrader(0, v0, map[string]string{"old": "new"})
+
+	if got, expected := upgrader.Version, 0; got != expected {
+		t.Errorf("got version %d, expected %d", got, expected)
+	}
+
+	if !upgrader.Type.Equals(v0.CoreConfigSchema().ImpliedType()) {
+		t.Errorf("got type %s, expected %s", upgrader.Type.FriendlyName(), v0.CoreConfigSchema().ImpliedType().FriendlyName())
+	}
+
+	got, err := upgrader.Upgrade(context.Background(), map[string]interface{}{"id": "test", "old": "value"}, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]interface{}{"id": "test", "new": "value"}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+}